@@ -11,6 +11,7 @@ require (
 	github.com/stretchr/testify v1.11.1
 	github.com/zitadel/oidc/v3 v3.45.5
 	go.uber.org/mock v0.6.0
+	golang.org/x/mod v0.34.0
 	golang.org/x/oauth2 v0.36.0
 )
 
@@ -96,7 +97,6 @@ require (
 	go.uber.org/fx v1.24.0
 	go.uber.org/multierr v1.11.0 // indirect
 	go.uber.org/zap v1.27.1 // indirect
-	golang.org/x/mod v0.34.0 // indirect
 	golang.org/x/net v0.52.0 // indirect
 	golang.org/x/sync v0.20.0 // indirect; indirectw
 	golang.org/x/sys v0.42.0 // indirect
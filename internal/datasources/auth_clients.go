@@ -0,0 +1,317 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &AuthClients{}
+	_ datasource.DataSourceWithConfigure = &AuthClients{}
+	_ datasource.DataSource              = &AuthClient{}
+	_ datasource.DataSourceWithConfigure = &AuthClient{}
+)
+
+type AuthClientItem struct {
+	ID                     types.String           `tfsdk:"id"`
+	Name                   types.String           `tfsdk:"name"`
+	Description            types.String           `tfsdk:"description"`
+	Scopes                 []types.String         `tfsdk:"scopes"`
+	RedirectURIs           []types.String         `tfsdk:"redirect_uris"`
+	PostLogoutRedirectURIs []types.String         `tfsdk:"post_logout_redirect_uris"`
+	Public                 types.Bool             `tfsdk:"public"`
+	Trusted                types.Bool             `tfsdk:"trusted"`
+	Metadata               types.Map              `tfsdk:"metadata"`
+	Secrets                []AuthClientSecretItem `tfsdk:"secrets"`
+}
+
+type AuthClientSecretItem struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	LastDigits types.String `tfsdk:"last_digits"`
+}
+
+func stringValues(values []string) []types.String {
+	return collectionutils.Map(values, types.StringValue)
+}
+
+// newAuthClientItem converts a client, the clear values of its secrets are never returned by the API.
+func newAuthClientItem(client shared.Client) AuthClientItem {
+	item := AuthClientItem{
+		ID:                     types.StringValue(client.ID),
+		Name:                   types.StringValue(client.Name),
+		Description:            types.StringPointerValue(client.Description),
+		Scopes:                 stringValues(client.Scopes),
+		RedirectURIs:           stringValues(client.RedirectUris),
+		PostLogoutRedirectURIs: stringValues(client.PostLogoutRedirectUris),
+		Public:                 types.BoolValue(client.Public != nil && *client.Public),
+		Trusted:                types.BoolValue(client.Trusted != nil && *client.Trusted),
+		Metadata: types.MapValueMust(types.StringType, collectionutils.ConvertMap(client.Metadata, func(v string) attr.Value {
+			return types.StringValue(v)
+		})),
+		Secrets: []AuthClientSecretItem{},
+	}
+	for _, secret := range client.Secrets {
+		item.Secrets = append(item.Secrets, AuthClientSecretItem{
+			ID:         types.StringValue(secret.ID),
+			Name:       types.StringValue(secret.Name),
+			LastDigits: types.StringValue(secret.LastDigits),
+		})
+	}
+	return item
+}
+
+// schemaAuthClientAttributes returns the attributes of a client, identified by the given attribute.
+func schemaAuthClientAttributes(id schema.StringAttribute) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": id,
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the client.",
+		},
+		"description": schema.StringAttribute{
+			Computed:    true,
+			Description: "The description of the client.",
+		},
+		"scopes": schema.SetAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The scopes the client can request.",
+		},
+		"redirect_uris": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The URIs the authorization server can redirect to after a login.",
+		},
+		"post_logout_redirect_uris": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The URIs the authorization server can redirect to after a logout.",
+		},
+		"public": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the client is public, that is it cannot keep a secret.",
+		},
+		"trusted": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the client is trusted, that is the user consent is not requested.",
+		},
+		"metadata": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The metadata of the client.",
+		},
+		"secrets": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The secrets of the client, without their clear values.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the secret.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the secret.",
+					},
+					"last_digits": schema.StringAttribute{
+						Computed:    true,
+						Description: "The last digits of the secret.",
+					},
+				},
+			},
+		},
+	}
+}
+
+type AuthClients struct {
+	store *internal.ModuleStore
+}
+
+type AuthClientsModel struct {
+	Trusted types.Bool       `tfsdk:"trusted"`
+	Clients []AuthClientItem `tfsdk:"clients"`
+}
+
+// filterClients keeps the clients matching the trusted filter of the model, sorted by name.
+func (m AuthClientsModel) filterClients(clients []shared.Client) []AuthClientItem {
+	items := []AuthClientItem{}
+	for _, client := range clients {
+		item := newAuthClientItem(client)
+		if !m.Trusted.IsNull() && item.Trusted.ValueBool() != m.Trusted.ValueBool() {
+			continue
+		}
+		items = append(items, item)
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Name.ValueString() < items[j].Name.ValueString()
+	})
+	return items
+}
+
+func NewAuthClients() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &AuthClients{}
+	}
+}
+
+var SchemaAuthClients = schema.Schema{
+	Description: "Data source listing the OAuth clients of the Formance Auth module, such as to check that no unexpected trusted client exists on the stack.",
+	Attributes: map[string]schema.Attribute{
+		"trusted": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Only return the trusted clients when `true`, or the clients which are not trusted when `false`.",
+		},
+		"clients": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The clients matching the filters, sorted by name.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: schemaAuthClientAttributes(schema.StringAttribute{
+					Computed:    true,
+					Description: "The unique identifier of the client, used as the OAuth client ID.",
+				}),
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *AuthClients) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaAuthClients
+}
+
+// Metadata implements datasource.DataSource.
+func (d *AuthClients) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_auth_clients"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *AuthClients) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("auth")
+}
+
+// Read implements datasource.DataSource.
+func (d *AuthClients) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config AuthClientsModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The auth API returns every client at once, it does not paginate.
+	resp, err := d.store.Auth().ListClients(ctx)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Clients = config.filterClients(resp.ListClientsResponse.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
+
+type AuthClient struct {
+	store *internal.ModuleStore
+}
+
+type AuthClientModel AuthClientItem
+
+func NewAuthClient() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &AuthClient{}
+	}
+}
+
+var SchemaAuthClient = schema.Schema{
+	Description: "Data source reading an OAuth client of the Formance Auth module.",
+	Attributes: schemaAuthClientAttributes(schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the client, used as the OAuth client ID.",
+	}),
+}
+
+// Schema implements datasource.DataSource.
+func (d *AuthClient) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaAuthClient
+}
+
+// Metadata implements datasource.DataSource.
+func (d *AuthClient) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_auth_client"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *AuthClient) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("auth")
+}
+
+// Read implements datasource.DataSource.
+func (d *AuthClient) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config AuthClientModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.store.Auth().ReadClient(ctx, operations.ReadClientRequest{
+		ClientID: config.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	client := resp.ReadClientResponse.Data
+	if client == nil {
+		res.Diagnostics.AddError("Missing Auth Client", "The API did not return the client.")
+		return
+	}
+	config = AuthClientModel(newAuthClientItem(*client))
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
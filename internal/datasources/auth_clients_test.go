@@ -0,0 +1,58 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClientsFilterClients(t *testing.T) {
+	t.Parallel()
+
+	clients := []shared.Client{
+		{ID: "2", Name: "payouts", Trusted: pointer.For(true)},
+		{ID: "1", Name: "dashboard", Public: pointer.For(true)},
+	}
+
+	items := AuthClientsModel{Trusted: types.BoolNull()}.filterClients(clients)
+	require.Len(t, items, 2)
+	require.Equal(t, "dashboard", items[0].Name.ValueString())
+	require.False(t, items[0].Trusted.ValueBool())
+	require.Equal(t, "payouts", items[1].Name.ValueString())
+
+	items = AuthClientsModel{Trusted: types.BoolValue(true)}.filterClients(clients)
+	require.Len(t, items, 1)
+	require.Equal(t, "2", items[0].ID.ValueString())
+
+	items = AuthClientsModel{Trusted: types.BoolValue(false)}.filterClients(clients)
+	require.Len(t, items, 1)
+	require.Equal(t, "1", items[0].ID.ValueString())
+}
+
+func TestNewAuthClientItem(t *testing.T) {
+	t.Parallel()
+
+	item := newAuthClientItem(shared.Client{
+		ID:       "client",
+		Name:     "payouts",
+		Scopes:   []string{"ledger:read"},
+		Metadata: map[string]string{"team": "payouts"},
+		Secrets: []shared.ClientSecret{
+			{ID: "secret", Name: "2026-10", LastDigits: "a1b2"},
+		},
+	})
+	require.True(t, item.Description.IsNull())
+	require.False(t, item.Public.ValueBool())
+	require.Equal(t, []types.String{types.StringValue("ledger:read")}, item.Scopes)
+	require.NotNil(t, item.RedirectURIs)
+	require.Empty(t, item.RedirectURIs)
+	require.Equal(t, types.StringValue("payouts"), item.Metadata.Elements()["team"])
+	require.Equal(t, []AuthClientSecretItem{{
+		ID:         types.StringValue("secret"),
+		Name:       types.StringValue("2026-10"),
+		LastDigits: types.StringValue("a1b2"),
+	}}, item.Secrets)
+}
@@ -0,0 +1,235 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &AuthUsers{}
+	_ datasource.DataSourceWithConfigure = &AuthUsers{}
+	_ datasource.DataSource              = &AuthUser{}
+	_ datasource.DataSourceWithConfigure = &AuthUser{}
+)
+
+type AuthUserItem struct {
+	ID      types.String `tfsdk:"id"`
+	Subject types.String `tfsdk:"subject"`
+	Email   types.String `tfsdk:"email"`
+}
+
+func newAuthUserItem(user shared.User) AuthUserItem {
+	return AuthUserItem{
+		ID:      types.StringPointerValue(user.ID),
+		Subject: types.StringPointerValue(user.Subject),
+		Email:   types.StringPointerValue(user.Email),
+	}
+}
+
+// schemaAuthUserAttributes returns the attributes of a user, identified by the given attribute.
+func schemaAuthUserAttributes(id schema.StringAttribute) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": id,
+		"subject": schema.StringAttribute{
+			Computed:    true,
+			Description: "The subject of the user at the identity provider of the stack.",
+		},
+		"email": schema.StringAttribute{
+			Computed:    true,
+			Description: "The email address of the user.",
+		},
+	}
+}
+
+type AuthUsers struct {
+	store *internal.ModuleStore
+}
+
+type AuthUsersModel struct {
+	Email types.String   `tfsdk:"email"`
+	Users []AuthUserItem `tfsdk:"users"`
+}
+
+// filterUsers keeps the users with the email of the model, compared case-insensitively, sorted by email.
+func (m AuthUsersModel) filterUsers(users []shared.User) []AuthUserItem {
+	items := []AuthUserItem{}
+	for _, user := range users {
+		if !m.Email.IsNull() && (user.Email == nil || !strings.EqualFold(*user.Email, m.Email.ValueString())) {
+			continue
+		}
+		items = append(items, newAuthUserItem(user))
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Email.ValueString() < items[j].Email.ValueString()
+	})
+	return items
+}
+
+func NewAuthUsers() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &AuthUsers{}
+	}
+}
+
+var SchemaAuthUsers = schema.Schema{
+	Description: "Data source listing the users of the Formance Auth module, that is the users who logged in to the stack through its identity provider.",
+	Attributes: map[string]schema.Attribute{
+		"email": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the users with this email address, compared case-insensitively.",
+		},
+		"users": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The users matching the filters, sorted by email address.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: schemaAuthUserAttributes(schema.StringAttribute{
+					Computed:    true,
+					Description: "The unique identifier of the user.",
+				}),
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *AuthUsers) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaAuthUsers
+}
+
+// Metadata implements datasource.DataSource.
+func (d *AuthUsers) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_auth_users"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *AuthUsers) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("auth")
+}
+
+// Read implements datasource.DataSource.
+func (d *AuthUsers) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config AuthUsersModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The auth API returns every user at once, it does not paginate.
+	resp, err := d.store.Auth().ListUsers(ctx)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Users = config.filterUsers(resp.ListUsersResponse.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
+
+type AuthUser struct {
+	store *internal.ModuleStore
+}
+
+type AuthUserModel AuthUserItem
+
+func NewAuthUser() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &AuthUser{}
+	}
+}
+
+var SchemaAuthUser = schema.Schema{
+	Description: "Data source reading a user of the Formance Auth module.",
+	Attributes: schemaAuthUserAttributes(schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the user.",
+	}),
+}
+
+// Schema implements datasource.DataSource.
+func (d *AuthUser) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaAuthUser
+}
+
+// Metadata implements datasource.DataSource.
+func (d *AuthUser) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_auth_user"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *AuthUser) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("auth")
+}
+
+// Read implements datasource.DataSource.
+func (d *AuthUser) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config AuthUserModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.store.Auth().ReadUser(ctx, operations.ReadUserRequest{
+		UserID: config.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	user := resp.ReadUserResponse.Data
+	if user == nil {
+		res.Diagnostics.AddError("Missing Auth User", "The API did not return the user.")
+		return
+	}
+	// The identifier is optional in the API responses, the configured one is kept
+	id := config.ID
+	config = AuthUserModel(newAuthUserItem(*user))
+	config.ID = id
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
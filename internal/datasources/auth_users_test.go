@@ -0,0 +1,37 @@
+package datasources
+
+import (
+	"testing"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthUsersFilterUsers(t *testing.T) {
+	t.Parallel()
+
+	users := []shared.User{
+		{ID: pointer.For("2"), Subject: pointer.For("sub-2"), Email: pointer.For("zoe@example.com")},
+		{ID: pointer.For("1"), Subject: pointer.For("sub-1"), Email: pointer.For("alice@example.com")},
+		{ID: pointer.For("3"), Subject: pointer.For("sub-3")},
+	}
+
+	items := AuthUsersModel{Email: types.StringNull()}.filterUsers(users)
+	require.Len(t, items, 3)
+	require.True(t, items[0].Email.IsNull())
+	require.Equal(t, "alice@example.com", items[1].Email.ValueString())
+	require.Equal(t, "zoe@example.com", items[2].Email.ValueString())
+
+	items = AuthUsersModel{Email: types.StringValue("Alice@Example.com")}.filterUsers(users)
+	require.Equal(t, []AuthUserItem{{
+		ID:      types.StringValue("1"),
+		Subject: types.StringValue("sub-1"),
+		Email:   types.StringValue("alice@example.com"),
+	}}, items)
+
+	items = AuthUsersModel{Email: types.StringValue("bob@example.com")}.filterUsers(users)
+	require.NotNil(t, items)
+	require.Empty(t, items)
+}
@@ -0,0 +1,169 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/terraform-provider-stack/pkg/tracing"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ datasource.DataSource                     = &DataSourceTracer{}
+	_ datasource.DataSourceWithConfigure        = &DataSourceTracer{}
+	_ datasource.DataSourceWithValidateConfig   = &DataSourceTracer{}
+	_ datasource.DataSourceWithConfigValidators = &DataSourceTracer{}
+)
+var (
+	ErrValidateConfig = fmt.Errorf("error during ValidateConfig")
+	ErrSchema         = fmt.Errorf("error during Schema")
+	ErrConfigure      = fmt.Errorf("error during Configure")
+	ErrRead           = fmt.Errorf("error during Read")
+)
+
+func injectTraceContext(ctx context.Context, res any, funcName string) context.Context {
+	name := reflect.TypeOf(res).Elem().Name()
+	ctx = logging.ContextWithField(ctx, "datasource", strings.ToLower(name))
+	ctx = logging.ContextWithField(ctx, "operation", strings.ToLower(funcName))
+
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsValid() {
+		return ctx
+	}
+
+	headerCarrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, headerCarrier)
+	for k, v := range headerCarrier {
+		ctx = logging.ContextWithField(ctx, k, v)
+	}
+
+	span.SetAttributes(
+		attribute.String("datasource", strings.ToLower(name)),
+		attribute.String("operation", strings.ToLower(funcName)),
+	)
+	return ctx
+}
+
+type DataSourceTracer struct {
+	tracer          trace.Tracer
+	logger          logging.Logger
+	underlyingValue any
+}
+
+func NewDataSourceTracer(tracer trace.Tracer, logger logging.Logger, res any) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &DataSourceTracer{
+			tracer:          tracer,
+			logger:          logger,
+			underlyingValue: res,
+		}
+	}
+}
+
+// ConfigValidators implements datasource.DataSourceWithConfigValidators.
+func (d *DataSourceTracer) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	operation := "ConfigValidators"
+	ctx = logging.ContextWithLogger(ctx, d.logger)
+	var validators []datasource.ConfigValidator
+	if v, ok := d.underlyingValue.(datasource.DataSourceWithConfigValidators); ok {
+		_ = tracing.TraceError(ctx, d.tracer, operation, func(ctx context.Context) error {
+			ctx = injectTraceContext(ctx, v, operation)
+			logging.FromContext(ctx).Debug("call")
+			defer logging.FromContext(ctx).Debug("completed")
+			validators = v.ConfigValidators(ctx)
+			return nil
+		})
+	}
+	return validators
+}
+
+// ValidateConfig implements datasource.DataSourceWithValidateConfig.
+func (d *DataSourceTracer) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	operation := "ValidateConfig"
+	ctx = logging.ContextWithLogger(ctx, d.logger)
+	if v, ok := d.underlyingValue.(datasource.DataSourceWithValidateConfig); ok {
+		_ = tracing.TraceError(ctx, d.tracer, operation, func(ctx context.Context) error {
+			ctx = injectTraceContext(ctx, v, operation)
+			logging.FromContext(ctx).Debug("call")
+			defer logging.FromContext(ctx).Debug("completed")
+			v.ValidateConfig(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return ErrValidateConfig
+			}
+			return nil
+		})
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *DataSourceTracer) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx = logging.ContextWithLogger(ctx, d.logger)
+	operation := "Configure"
+	if v, ok := d.underlyingValue.(datasource.DataSourceWithConfigure); ok {
+		_ = tracing.TraceError(ctx, d.tracer, operation, func(ctx context.Context) error {
+			ctx = injectTraceContext(ctx, v, operation)
+			logging.FromContext(ctx).Debug("call")
+			defer logging.FromContext(ctx).Debug("completed")
+			v.Configure(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return ErrConfigure
+			}
+			return nil
+		})
+	}
+}
+
+// Metadata implements datasource.DataSource.
+func (d *DataSourceTracer) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	ctx = logging.ContextWithLogger(ctx, d.logger)
+	operation := "Metadata"
+	if v, ok := d.underlyingValue.(datasource.DataSource); ok {
+		_ = tracing.TraceError(ctx, d.tracer, operation, func(ctx context.Context) error {
+			ctx = injectTraceContext(ctx, v, operation)
+			logging.FromContext(ctx).Debug("call")
+			defer logging.FromContext(ctx).Debug("completed")
+			v.Metadata(ctx, req, resp)
+			return nil
+		})
+	}
+}
+
+// Read implements datasource.DataSource.
+func (d *DataSourceTracer) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.ContextWithLogger(ctx, d.logger)
+	operation := "Read"
+	if v, ok := d.underlyingValue.(datasource.DataSource); ok {
+		_ = tracing.TraceError(ctx, d.tracer, operation, func(ctx context.Context) error {
+			ctx = injectTraceContext(ctx, v, operation)
+			logging.FromContext(ctx).Debug("call")
+			defer logging.FromContext(ctx).Debug("completed")
+			v.Read(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return ErrRead
+			}
+			return nil
+		})
+	}
+}
+
+// Schema implements datasource.DataSource.
+func (d *DataSourceTracer) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx = logging.ContextWithLogger(ctx, d.logger)
+	operation := "Schema"
+	if v, ok := d.underlyingValue.(datasource.DataSource); ok {
+		_ = tracing.TraceError(ctx, d.tracer, operation, func(ctx context.Context) error {
+			ctx = injectTraceContext(ctx, v, operation)
+			v.Schema(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				return ErrSchema
+			}
+			return nil
+		})
+	}
+}
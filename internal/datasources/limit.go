@@ -0,0 +1,29 @@
+package datasources
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultRecentLimit is the number of recent items returned by the data sources listing a history when no limit is set.
+const defaultRecentLimit = 20
+
+// recentLimit returns the number of recent items to fetch.
+func recentLimit(limit types.Int64) int {
+	if limit.IsNull() || limit.IsUnknown() {
+		return defaultRecentLimit
+	}
+	return int(limit.ValueInt64())
+}
+
+var recentLimitAttribute = schema.Int64Attribute{
+	Optional:    true,
+	Description: fmt.Sprintf("The maximum number of recent items to return, between 1 and 1000. Defaults to %d.", defaultRecentLimit),
+	Validators: []validator.Int64{
+		int64validator.Between(1, 1000),
+	},
+}
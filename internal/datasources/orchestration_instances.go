@@ -0,0 +1,392 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &OrchestrationInstances{}
+	_ datasource.DataSourceWithConfigure = &OrchestrationInstances{}
+	_ datasource.DataSource              = &OrchestrationInstance{}
+	_ datasource.DataSourceWithConfigure = &OrchestrationInstance{}
+)
+
+const (
+	OrchestrationInstanceStatusRunning   = "RUNNING"
+	OrchestrationInstanceStatusSucceeded = "SUCCEEDED"
+	OrchestrationInstanceStatusFailed    = "FAILED"
+)
+
+type OrchestrationInstanceItem struct {
+	ID           types.String                       `tfsdk:"id"`
+	WorkflowID   types.String                       `tfsdk:"workflow_id"`
+	Status       types.String                       `tfsdk:"status"`
+	Error        types.String                       `tfsdk:"error"`
+	CreatedAt    types.String                       `tfsdk:"created_at"`
+	UpdatedAt    types.String                       `tfsdk:"updated_at"`
+	TerminatedAt types.String                       `tfsdk:"terminated_at"`
+	History      []OrchestrationInstanceHistoryItem `tfsdk:"history"`
+}
+
+type OrchestrationInstanceHistoryItem struct {
+	Name         types.String `tfsdk:"name"`
+	Input        types.String `tfsdk:"input"`
+	Error        types.String `tfsdk:"error"`
+	Terminated   types.Bool   `tfsdk:"terminated"`
+	StartedAt    types.String `tfsdk:"started_at"`
+	TerminatedAt types.String `tfsdk:"terminated_at"`
+}
+
+// instanceStatus summarizes the state of a workflow instance.
+func instanceStatus(terminated bool, err *string) string {
+	switch {
+	case !terminated:
+		return OrchestrationInstanceStatusRunning
+	case err != nil && *err != "":
+		return OrchestrationInstanceStatusFailed
+	default:
+		return OrchestrationInstanceStatusSucceeded
+	}
+}
+
+func formatOptionalTime(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// newOrchestrationInstanceItem converts an instance and the stages it ran.
+// The input of a stage is null when the API returns a stage kind unknown to the provider.
+func newOrchestrationInstanceItem(instance shared.V2WorkflowInstance, history []shared.V2WorkflowInstanceHistory) OrchestrationInstanceItem {
+	item := OrchestrationInstanceItem{
+		ID:           types.StringValue(instance.ID),
+		WorkflowID:   types.StringValue(instance.WorkflowID),
+		Status:       types.StringValue(instanceStatus(instance.Terminated, instance.Error)),
+		Error:        types.StringPointerValue(instance.Error),
+		CreatedAt:    types.StringValue(instance.CreatedAt.UTC().Format(time.RFC3339)),
+		UpdatedAt:    types.StringValue(instance.UpdatedAt.UTC().Format(time.RFC3339)),
+		TerminatedAt: formatOptionalTime(instance.TerminatedAt),
+		History:      []OrchestrationInstanceHistoryItem{},
+	}
+	for _, stage := range history {
+		input := types.StringNull()
+		if data, err := json.Marshal(stage.Input); err == nil {
+			input = types.StringValue(string(data))
+		}
+		item.History = append(item.History, OrchestrationInstanceHistoryItem{
+			Name:         types.StringValue(stage.Name),
+			Input:        input,
+			Error:        types.StringPointerValue(stage.Error),
+			Terminated:   types.BoolValue(stage.Terminated),
+			StartedAt:    types.StringValue(stage.StartedAt.UTC().Format(time.RFC3339)),
+			TerminatedAt: formatOptionalTime(stage.TerminatedAt),
+		})
+	}
+	return item
+}
+
+// sortInstances orders the instances most recently created first.
+func sortInstances(instances []shared.V2WorkflowInstance) {
+	sort.SliceStable(instances, func(i, j int) bool {
+		return instances[i].CreatedAt.After(instances[j].CreatedAt)
+	})
+}
+
+// schemaOrchestrationInstanceAttributes returns the attributes of an instance, identified by the given attribute.
+func schemaOrchestrationInstanceAttributes(id schema.StringAttribute) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": id,
+		"workflow_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the workflow run by the instance.",
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the instance, one of `RUNNING`, `SUCCEEDED` or `FAILED`.",
+		},
+		"error": schema.StringAttribute{
+			Computed:    true,
+			Description: "The error which stopped the instance, if any.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The start date of the instance, in RFC 3339 format.",
+		},
+		"updated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The last update date of the instance, in RFC 3339 format.",
+		},
+		"terminated_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The termination date of the instance, in RFC 3339 format.",
+		},
+		"history": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The stages run by the instance, in order.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The kind of the stage, such as `send` or `delay`.",
+					},
+					"input": schema.StringAttribute{
+						Computed:    true,
+						Description: "The JSON encoded input of the stage, with its variables resolved. Use `jsondecode` to read it. Null for stage kinds unknown to the provider.",
+					},
+					"error": schema.StringAttribute{
+						Computed:    true,
+						Description: "The error raised by the stage, if any.",
+					},
+					"terminated": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the stage is terminated.",
+					},
+					"started_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The start date of the stage, in RFC 3339 format.",
+					},
+					"terminated_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The termination date of the stage, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// instanceHistory fetches the stages run by the instance.
+func instanceHistory(ctx context.Context, store *internal.ModuleStore, instanceID string) ([]shared.V2WorkflowInstanceHistory, error) {
+	resp, err := store.Orchestration().GetInstanceHistory(ctx, operations.V2GetInstanceHistoryRequest{
+		InstanceID: instanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.V2GetWorkflowInstanceHistoryResponse.Data, nil
+}
+
+type OrchestrationInstances struct {
+	store *internal.ModuleStore
+}
+
+type OrchestrationInstancesModel struct {
+	WorkflowID types.String                `tfsdk:"workflow_id"`
+	Running    types.Bool                  `tfsdk:"running"`
+	Limit      types.Int64                 `tfsdk:"limit"`
+	Instances  []OrchestrationInstanceItem `tfsdk:"instances"`
+}
+
+func NewOrchestrationInstances() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &OrchestrationInstances{}
+	}
+}
+
+var SchemaOrchestrationInstances = schema.Schema{
+	Description: "Data source listing the recent instances of Formance Orchestration workflows, with the history of their stages.",
+	Attributes: map[string]schema.Attribute{
+		"workflow_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the instances of this workflow.",
+		},
+		"running": schema.BoolAttribute{
+			Optional:    true,
+			Description: "Only return the running instances when `true`.",
+		},
+		"limit": recentLimitAttribute,
+		"instances": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The instances matching the filters, most recently created first.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: schemaOrchestrationInstanceAttributes(schema.StringAttribute{
+					Computed:    true,
+					Description: "The unique identifier of the instance.",
+				}),
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *OrchestrationInstances) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaOrchestrationInstances
+}
+
+// Metadata implements datasource.DataSource.
+func (d *OrchestrationInstances) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_orchestration_instances"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *OrchestrationInstances) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("orchestration")
+}
+
+// Read implements datasource.DataSource.
+func (d *OrchestrationInstances) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config OrchestrationInstancesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The API lists the instances most recently created first, only the pages holding the requested instances are fetched.
+	limit := recentLimit(config.Limit)
+	sdkOrchestration := d.store.Orchestration()
+	instances, err := sdk.PaginateLimit(ctx, limit, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.V2WorkflowInstance], error) {
+		request := operations.V2ListInstancesRequest{
+			Cursor: cursor,
+		}
+		if cursor == nil {
+			request.WorkflowID = config.WorkflowID.ValueStringPointer()
+			request.Running = config.Running.ValueBoolPointer()
+			request.PageSize = pointer.For(int64(min(limit, 100)))
+		}
+		resp, err := sdkOrchestration.ListInstances(ctx, request)
+		if err != nil {
+			return sdk.Cursor[shared.V2WorkflowInstance]{}, err
+		}
+		cursorResp := resp.V2ListRunsResponse.Cursor
+		return sdk.Cursor[shared.V2WorkflowInstance]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+	sortInstances(instances)
+
+	config.Instances = []OrchestrationInstanceItem{}
+	for _, instance := range instances {
+		history, err := instanceHistory(ctx, d.store, instance.ID)
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+		config.Instances = append(config.Instances, newOrchestrationInstanceItem(instance, history))
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
+
+type OrchestrationInstance struct {
+	store *internal.ModuleStore
+}
+
+type OrchestrationInstanceModel struct {
+	ID           types.String                       `tfsdk:"id"`
+	WorkflowID   types.String                       `tfsdk:"workflow_id"`
+	Status       types.String                       `tfsdk:"status"`
+	Error        types.String                       `tfsdk:"error"`
+	CreatedAt    types.String                       `tfsdk:"created_at"`
+	UpdatedAt    types.String                       `tfsdk:"updated_at"`
+	TerminatedAt types.String                       `tfsdk:"terminated_at"`
+	History      []OrchestrationInstanceHistoryItem `tfsdk:"history"`
+}
+
+func NewOrchestrationInstance() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &OrchestrationInstance{}
+	}
+}
+
+var SchemaOrchestrationInstance = schema.Schema{
+	Description: "Data source reading a Formance Orchestration workflow instance, with the history of its stages.",
+	Attributes: schemaOrchestrationInstanceAttributes(schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the instance.",
+	}),
+}
+
+// Schema implements datasource.DataSource.
+func (d *OrchestrationInstance) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaOrchestrationInstance
+}
+
+// Metadata implements datasource.DataSource.
+func (d *OrchestrationInstance) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_orchestration_instance"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *OrchestrationInstance) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("orchestration")
+}
+
+// Read implements datasource.DataSource.
+func (d *OrchestrationInstance) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config OrchestrationInstanceModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.store.Orchestration().GetInstance(ctx, operations.V2GetInstanceRequest{
+		InstanceID: config.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+	history, err := instanceHistory(ctx, d.store, config.ID.ValueString())
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config = OrchestrationInstanceModel(newOrchestrationInstanceItem(resp.V2GetWorkflowInstanceResponse.Data, history))
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
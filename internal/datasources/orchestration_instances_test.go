@@ -0,0 +1,103 @@
+package datasources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrchestrationInstanceItem(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))
+	terminatedAt := startedAt.Add(time.Minute)
+	item := newOrchestrationInstanceItem(shared.V2WorkflowInstance{
+		ID:           "instance",
+		WorkflowID:   "workflow",
+		CreatedAt:    startedAt,
+		UpdatedAt:    terminatedAt,
+		Terminated:   true,
+		TerminatedAt: &terminatedAt,
+		Error:        pointer.For("insufficient funds"),
+	}, []shared.V2WorkflowInstanceHistory{{
+		Name:         "send",
+		Input:        shared.CreateV2StageV2StageWaitEvent(shared.V2StageWaitEvent{Event: "confirmed"}),
+		StartedAt:    startedAt,
+		Terminated:   true,
+		TerminatedAt: &terminatedAt,
+		Error:        pointer.For("insufficient funds"),
+	}})
+	require.Equal(t, OrchestrationInstanceItem{
+		ID:           types.StringValue("instance"),
+		WorkflowID:   types.StringValue("workflow"),
+		Status:       types.StringValue(OrchestrationInstanceStatusFailed),
+		Error:        types.StringValue("insufficient funds"),
+		CreatedAt:    types.StringValue("2025-01-01T00:00:00Z"),
+		UpdatedAt:    types.StringValue("2025-01-01T00:01:00Z"),
+		TerminatedAt: types.StringValue("2025-01-01T00:01:00Z"),
+		History: []OrchestrationInstanceHistoryItem{{
+			Name:         types.StringValue("send"),
+			Input:        types.StringValue(`{"event":"confirmed"}`),
+			Error:        types.StringValue("insufficient funds"),
+			Terminated:   types.BoolValue(true),
+			StartedAt:    types.StringValue("2025-01-01T00:00:00Z"),
+			TerminatedAt: types.StringValue("2025-01-01T00:01:00Z"),
+		}},
+	}, item)
+
+	// Stages unknown to the SDK have no input
+	item = newOrchestrationInstanceItem(shared.V2WorkflowInstance{ID: "instance"}, []shared.V2WorkflowInstanceHistory{{Name: "unknown"}})
+	require.True(t, item.History[0].Input.IsNull())
+
+	require.Equal(t, OrchestrationInstanceStatusRunning, instanceStatus(false, nil))
+	require.Equal(t, OrchestrationInstanceStatusSucceeded, instanceStatus(true, pointer.For("")))
+}
+
+func TestOrchestrationTriggerOccurrences(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	var model OrchestrationTriggerOccurrencesModel
+	diags := model.fromOccurrences([]shared.V2TriggerOccurrence{
+		{
+			Date:               first,
+			TriggerID:          "trigger",
+			Event:              map[string]any{"amount": 100},
+			WorkflowInstanceID: pointer.For("instance-1"),
+		},
+		{
+			Date:      second,
+			TriggerID: "trigger",
+			Event:     map[string]any{"amount": 2000},
+			WorkflowInstance: &shared.V2WorkflowInstance{
+				ID:         "instance-2",
+				Terminated: true,
+			},
+		},
+	})
+	require.False(t, diags.HasError())
+	require.Equal(t, []OrchestrationTriggerOccurrenceItem{
+		{
+			Date:                   types.StringValue("2025-01-01T01:00:00Z"),
+			Event:                  types.StringValue(`{"amount":2000}`),
+			Error:                  types.StringNull(),
+			WorkflowInstanceID:     types.StringValue("instance-2"),
+			WorkflowInstanceStatus: types.StringValue(OrchestrationInstanceStatusSucceeded),
+			WorkflowInstanceError:  types.StringNull(),
+		},
+		{
+			Date:                   types.StringValue("2025-01-01T00:00:00Z"),
+			Event:                  types.StringValue(`{"amount":100}`),
+			Error:                  types.StringNull(),
+			WorkflowInstanceID:     types.StringValue("instance-1"),
+			WorkflowInstanceStatus: types.StringNull(),
+			WorkflowInstanceError:  types.StringNull(),
+		},
+	}, model.Occurrences)
+}
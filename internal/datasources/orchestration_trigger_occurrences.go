@@ -0,0 +1,197 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &OrchestrationTriggerOccurrences{}
+	_ datasource.DataSourceWithConfigure = &OrchestrationTriggerOccurrences{}
+)
+
+type OrchestrationTriggerOccurrences struct {
+	store *internal.ModuleStore
+}
+
+type OrchestrationTriggerOccurrencesModel struct {
+	TriggerID   types.String                         `tfsdk:"trigger_id"`
+	Limit       types.Int64                          `tfsdk:"limit"`
+	Occurrences []OrchestrationTriggerOccurrenceItem `tfsdk:"occurrences"`
+}
+
+type OrchestrationTriggerOccurrenceItem struct {
+	Date                   types.String `tfsdk:"date"`
+	Event                  types.String `tfsdk:"event"`
+	Error                  types.String `tfsdk:"error"`
+	WorkflowInstanceID     types.String `tfsdk:"workflow_instance_id"`
+	WorkflowInstanceStatus types.String `tfsdk:"workflow_instance_status"`
+	WorkflowInstanceError  types.String `tfsdk:"workflow_instance_error"`
+}
+
+// fromOccurrences fills the occurrences of the model, most recent first.
+func (m *OrchestrationTriggerOccurrencesModel) fromOccurrences(occurrences []shared.V2TriggerOccurrence) diag.Diagnostics {
+	var diags diag.Diagnostics
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		return occurrences[i].Date.After(occurrences[j].Date)
+	})
+
+	m.Occurrences = []OrchestrationTriggerOccurrenceItem{}
+	for _, occurrence := range occurrences {
+		event, err := json.Marshal(occurrence.Event)
+		if err != nil {
+			diags.AddError("Invalid Trigger Occurrence", fmt.Sprintf("Failed to encode the event of trigger %s: %v", occurrence.TriggerID, err))
+			continue
+		}
+
+		item := OrchestrationTriggerOccurrenceItem{
+			Date:                   types.StringValue(occurrence.Date.UTC().Format(time.RFC3339)),
+			Event:                  types.StringValue(string(event)),
+			Error:                  types.StringPointerValue(occurrence.Error),
+			WorkflowInstanceID:     types.StringPointerValue(occurrence.WorkflowInstanceID),
+			WorkflowInstanceStatus: types.StringNull(),
+			WorkflowInstanceError:  types.StringNull(),
+		}
+		if instance := occurrence.WorkflowInstance; instance != nil {
+			item.WorkflowInstanceID = types.StringValue(instance.ID)
+			item.WorkflowInstanceStatus = types.StringValue(instanceStatus(instance.Terminated, instance.Error))
+			item.WorkflowInstanceError = types.StringPointerValue(instance.Error)
+		}
+		m.Occurrences = append(m.Occurrences, item)
+	}
+	return diags
+}
+
+func NewOrchestrationTriggerOccurrences() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &OrchestrationTriggerOccurrences{}
+	}
+}
+
+var SchemaOrchestrationTriggerOccurrences = schema.Schema{
+	Description: "Data source listing the recent occurrences of a Formance Orchestration trigger, that is the events which matched it and the workflow instances they started.",
+	Attributes: map[string]schema.Attribute{
+		"trigger_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the trigger.",
+		},
+		"limit": recentLimitAttribute,
+		"occurrences": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The occurrences of the trigger, most recent first.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"date": schema.StringAttribute{
+						Computed:    true,
+						Description: "The date of the occurrence, in RFC 3339 format.",
+					},
+					"event": schema.StringAttribute{
+						Computed:    true,
+						Description: "The JSON encoded event which matched the trigger. Use `jsondecode` to read it.",
+					},
+					"error": schema.StringAttribute{
+						Computed:    true,
+						Description: "The error raised when starting the workflow, if any.",
+					},
+					"workflow_instance_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the workflow instance started by the occurrence.",
+					},
+					"workflow_instance_status": schema.StringAttribute{
+						Computed:    true,
+						Description: "The status of the workflow instance, one of `RUNNING`, `SUCCEEDED` or `FAILED`. Null when the API does not return the instance.",
+					},
+					"workflow_instance_error": schema.StringAttribute{
+						Computed:    true,
+						Description: "The error which stopped the workflow instance, if any.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *OrchestrationTriggerOccurrences) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaOrchestrationTriggerOccurrences
+}
+
+// Metadata implements datasource.DataSource.
+func (d *OrchestrationTriggerOccurrences) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_orchestration_trigger_occurrences"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *OrchestrationTriggerOccurrences) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("orchestration")
+}
+
+// Read implements datasource.DataSource.
+func (d *OrchestrationTriggerOccurrences) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config OrchestrationTriggerOccurrencesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The API lists the occurrences most recent first, only the pages holding the requested occurrences are fetched.
+	limit := recentLimit(config.Limit)
+	sdkOrchestration := d.store.Orchestration()
+	occurrences, err := sdk.PaginateLimit(ctx, limit, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.V2TriggerOccurrence], error) {
+		request := operations.V2ListTriggersOccurrencesRequest{
+			TriggerID: config.TriggerID.ValueString(),
+			Cursor:    cursor,
+		}
+		if cursor == nil {
+			request.PageSize = pointer.For(int64(min(limit, 100)))
+		}
+		resp, err := sdkOrchestration.ListTriggersOccurrences(ctx, request)
+		if err != nil {
+			return sdk.Cursor[shared.V2TriggerOccurrence]{}, err
+		}
+		cursorResp := resp.V2ListTriggersOccurrencesResponse.Cursor
+		return sdk.Cursor[shared.V2TriggerOccurrence]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(config.fromOccurrences(occurrences)...)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
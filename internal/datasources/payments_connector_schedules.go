@@ -0,0 +1,329 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &PaymentsConnectorSchedules{}
+	_ datasource.DataSourceWithConfigure = &PaymentsConnectorSchedules{}
+	_ datasource.DataSource              = &PaymentsConnectorScheduleInstances{}
+	_ datasource.DataSourceWithConfigure = &PaymentsConnectorScheduleInstances{}
+)
+
+type PaymentsConnectorSchedules struct {
+	store *internal.ModuleStore
+}
+
+type PaymentsConnectorSchedulesModel struct {
+	ConnectorID types.String                    `tfsdk:"connector_id"`
+	Schedules   []PaymentsConnectorScheduleItem `tfsdk:"schedules"`
+}
+
+type PaymentsConnectorScheduleItem struct {
+	ID        types.String `tfsdk:"id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func NewPaymentsConnectorSchedules() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &PaymentsConnectorSchedules{}
+	}
+}
+
+var SchemaPaymentsConnectorSchedules = schema.Schema{
+	Description: "Data source listing the polling schedules of a Formance Payments Connector.",
+	Attributes: map[string]schema.Attribute{
+		"connector_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the connector.",
+		},
+		"schedules": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The schedules of the connector.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the schedule.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The creation date of the schedule, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *PaymentsConnectorSchedules) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaPaymentsConnectorSchedules
+}
+
+// Metadata implements datasource.DataSource.
+func (d *PaymentsConnectorSchedules) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_payments_connector_schedules"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *PaymentsConnectorSchedules) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("payments")
+}
+
+// Read implements datasource.DataSource.
+func (d *PaymentsConnectorSchedules) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config PaymentsConnectorSchedulesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	sdkPayments := d.store.Payments()
+	schedules, err := sdk.Paginate(ctx, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.V3Schedule], error) {
+		resp, err := sdkPayments.ListConnectorSchedules(ctx, operations.V3ListConnectorSchedulesRequest{
+			ConnectorID: config.ConnectorID.ValueString(),
+			Cursor:      cursor,
+		})
+		if err != nil {
+			return sdk.Cursor[shared.V3Schedule]{}, err
+		}
+		cursorResp := resp.V3ConnectorSchedulesCursorResponse.Cursor
+		return sdk.Cursor[shared.V3Schedule]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Schedules = []PaymentsConnectorScheduleItem{}
+	for _, schedule := range schedules {
+		config.Schedules = append(config.Schedules, PaymentsConnectorScheduleItem{
+			ID:        types.StringValue(schedule.ID),
+			CreatedAt: types.StringValue(schedule.CreatedAt.UTC().Format(time.RFC3339)),
+		})
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
+
+type PaymentsConnectorScheduleInstances struct {
+	store *internal.ModuleStore
+}
+
+type PaymentsConnectorScheduleInstancesModel struct {
+	ConnectorID  types.String                            `tfsdk:"connector_id"`
+	ScheduleID   types.String                            `tfsdk:"schedule_id"`
+	Limit        types.Int64                             `tfsdk:"limit"`
+	Instances    []PaymentsConnectorScheduleInstanceItem `tfsdk:"instances"`
+	LastInstance *PaymentsConnectorScheduleInstanceItem  `tfsdk:"last_instance"`
+}
+
+type PaymentsConnectorScheduleInstanceItem struct {
+	ID           types.String `tfsdk:"id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	UpdatedAt    types.String `tfsdk:"updated_at"`
+	Terminated   types.Bool   `tfsdk:"terminated"`
+	TerminatedAt types.String `tfsdk:"terminated_at"`
+	Error        types.String `tfsdk:"error"`
+}
+
+// fromInstances fills the instances of the model and selects the most recently created one as the last run.
+func (m *PaymentsConnectorScheduleInstancesModel) fromInstances(instances []shared.V3Instance) {
+	m.Instances = []PaymentsConnectorScheduleInstanceItem{}
+	m.LastInstance = nil
+
+	var last *shared.V3Instance
+	for i, instance := range instances {
+		m.Instances = append(m.Instances, newPaymentsConnectorScheduleInstanceItem(instance))
+		if last == nil || instance.CreatedAt.After(last.CreatedAt) {
+			last = &instances[i]
+		}
+	}
+
+	if last != nil {
+		item := newPaymentsConnectorScheduleInstanceItem(*last)
+		m.LastInstance = &item
+	}
+}
+
+func newPaymentsConnectorScheduleInstanceItem(instance shared.V3Instance) PaymentsConnectorScheduleInstanceItem {
+	item := PaymentsConnectorScheduleInstanceItem{
+		ID:           types.StringValue(instance.ID),
+		CreatedAt:    types.StringValue(instance.CreatedAt.UTC().Format(time.RFC3339)),
+		UpdatedAt:    types.StringValue(instance.UpdatedAt.UTC().Format(time.RFC3339)),
+		Terminated:   types.BoolValue(instance.Terminated),
+		TerminatedAt: types.StringNull(),
+		Error:        types.StringPointerValue(instance.Error),
+	}
+	if instance.TerminatedAt != nil {
+		item.TerminatedAt = types.StringValue(instance.TerminatedAt.UTC().Format(time.RFC3339))
+	}
+	return item
+}
+
+func NewPaymentsConnectorScheduleInstances() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &PaymentsConnectorScheduleInstances{}
+	}
+}
+
+var schemaPaymentsConnectorScheduleInstanceAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed:    true,
+		Description: "The unique identifier of the run.",
+	},
+	"created_at": schema.StringAttribute{
+		Computed:    true,
+		Description: "The start date of the run, in RFC 3339 format.",
+	},
+	"updated_at": schema.StringAttribute{
+		Computed:    true,
+		Description: "The last update date of the run, in RFC 3339 format.",
+	},
+	"terminated": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the run is terminated.",
+	},
+	"terminated_at": schema.StringAttribute{
+		Computed:    true,
+		Description: "The termination date of the run, in RFC 3339 format.",
+	},
+	"error": schema.StringAttribute{
+		Computed:    true,
+		Description: "The error reported by the run, if any.",
+	},
+}
+
+var SchemaPaymentsConnectorScheduleInstances = schema.Schema{
+	Description: "Data source listing the runs of a Formance Payments Connector polling schedule.",
+	Attributes: map[string]schema.Attribute{
+		"connector_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the connector.",
+		},
+		"schedule_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the schedule.",
+		},
+		"limit": recentLimitAttribute,
+		"instances": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The most recent runs of the schedule, up to `limit`.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: schemaPaymentsConnectorScheduleInstanceAttributes,
+			},
+		},
+		"last_instance": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The most recent run of the schedule, null if the schedule never ran.",
+			Attributes:  schemaPaymentsConnectorScheduleInstanceAttributes,
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *PaymentsConnectorScheduleInstances) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaPaymentsConnectorScheduleInstances
+}
+
+// Metadata implements datasource.DataSource.
+func (d *PaymentsConnectorScheduleInstances) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_payments_connector_schedule_instances"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *PaymentsConnectorScheduleInstances) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("payments")
+}
+
+// Read implements datasource.DataSource.
+func (d *PaymentsConnectorScheduleInstances) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config PaymentsConnectorScheduleInstancesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The API lists the runs most recently created first, only the pages holding the requested runs are fetched.
+	limit := recentLimit(config.Limit)
+	sdkPayments := d.store.Payments()
+	instances, err := sdk.PaginateLimit(ctx, limit, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.V3Instance], error) {
+		request := operations.V3ListConnectorScheduleInstancesRequest{
+			ConnectorID: config.ConnectorID.ValueString(),
+			ScheduleID:  config.ScheduleID.ValueString(),
+			Cursor:      cursor,
+		}
+		if cursor == nil {
+			request.PageSize = pointer.For(int64(min(limit, 100)))
+		}
+		resp, err := sdkPayments.ListConnectorScheduleInstances(ctx, request)
+		if err != nil {
+			return sdk.Cursor[shared.V3Instance]{}, err
+		}
+		cursorResp := resp.V3ConnectorScheduleInstancesCursorResponse.Cursor
+		return sdk.Cursor[shared.V3Instance]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.fromInstances(instances)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,311 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/resources"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &PaymentsConnectors{}
+	_ datasource.DataSourceWithConfigure = &PaymentsConnectors{}
+	_ datasource.DataSource              = &PaymentsConnector{}
+	_ datasource.DataSourceWithConfigure = &PaymentsConnector{}
+)
+
+type PaymentsConnectors struct {
+	store *internal.ModuleStore
+}
+
+type PaymentsConnectorsModel struct {
+	Provider   types.String            `tfsdk:"connector_provider"`
+	Name       types.String            `tfsdk:"name"`
+	Connectors []PaymentsConnectorItem `tfsdk:"connectors"`
+}
+
+type PaymentsConnectorItem struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Provider             types.String `tfsdk:"provider"`
+	Reference            types.String `tfsdk:"reference"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+	ScheduledForDeletion types.Bool   `tfsdk:"scheduled_for_deletion"`
+}
+
+// filterConnectors keeps the connectors matching the provider and name filters of the model.
+// The provider is compared case-insensitively as the API is not consistent on its casing.
+func (m PaymentsConnectorsModel) filterConnectors(connectors []shared.V3Connector) []PaymentsConnectorItem {
+	items := []PaymentsConnectorItem{}
+	for _, connector := range connectors {
+		if !m.Provider.IsNull() && !strings.EqualFold(connector.Provider, m.Provider.ValueString()) {
+			continue
+		}
+		if !m.Name.IsNull() && connector.Name != m.Name.ValueString() {
+			continue
+		}
+		items = append(items, PaymentsConnectorItem{
+			ID:                   types.StringValue(connector.ID),
+			Name:                 types.StringValue(connector.Name),
+			Provider:             types.StringValue(connector.Provider),
+			Reference:            types.StringValue(connector.Reference),
+			CreatedAt:            types.StringValue(connector.CreatedAt.UTC().Format(time.RFC3339)),
+			ScheduledForDeletion: types.BoolValue(connector.ScheduledForDeletion),
+		})
+	}
+	return items
+}
+
+func NewPaymentsConnectors() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &PaymentsConnectors{}
+	}
+}
+
+var SchemaPaymentsConnectors = schema.Schema{
+	Description: "Data source listing the Formance Payments Connectors installed on the stack.",
+	Attributes: map[string]schema.Attribute{
+		"connector_provider": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the connectors of this provider, compared case-insensitively.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the connector with this name.",
+		},
+		"connectors": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The connectors matching the filters.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the connector.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the connector.",
+					},
+					"provider": schema.StringAttribute{
+						Computed:    true,
+						Description: "The provider of the connector.",
+					},
+					"reference": schema.StringAttribute{
+						Computed:    true,
+						Description: "The reference of the connector.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The creation date of the connector, in RFC 3339 format.",
+					},
+					"scheduled_for_deletion": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether the connector is being uninstalled.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *PaymentsConnectors) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaPaymentsConnectors
+}
+
+// Metadata implements datasource.DataSource.
+func (d *PaymentsConnectors) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_payments_connectors"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *PaymentsConnectors) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("payments")
+}
+
+// Read implements datasource.DataSource.
+func (d *PaymentsConnectors) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config PaymentsConnectorsModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	sdkPayments := d.store.Payments()
+	connectors, err := sdk.Paginate(ctx, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.V3Connector], error) {
+		resp, err := sdkPayments.ListConnectors(ctx, operations.V3ListConnectorsRequest{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return sdk.Cursor[shared.V3Connector]{}, err
+		}
+		cursorResp := resp.V3ConnectorsCursorResponse.Cursor
+		return sdk.Cursor[shared.V3Connector]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Connectors = config.filterConnectors(connectors)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
+
+type PaymentsConnector struct {
+	store *internal.ModuleStore
+}
+
+type PaymentsConnectorModel struct {
+	ID       types.String  `tfsdk:"id"`
+	Name     types.String  `tfsdk:"name"`
+	Provider types.String  `tfsdk:"connector_provider"`
+	Config   types.Dynamic `tfsdk:"config"`
+}
+
+// fromConnectorConfig fills the model from the connector configuration returned by the API.
+func (m *PaymentsConnectorModel) fromConnectorConfig(resp *shared.V3InstallConnectorRequest) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("failed to marshal connector config response: %w", err)
+	}
+
+	values := make(map[string]any)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("failed to unmarshal connector config: %w", err)
+	}
+
+	m.Name = types.StringNull()
+	if name, ok := values["name"].(string); ok {
+		m.Name = types.StringValue(name)
+	}
+
+	m.Provider = types.StringValue(string(resp.Type))
+	if provider, ok := values["provider"].(string); ok {
+		m.Provider = types.StringValue(provider)
+	}
+
+	config := resources.ConvertToAttrValues(values)
+	m.Config = types.DynamicValue(resources.NewDynamicObjectValue(config).Value())
+
+	return nil
+}
+
+func NewPaymentsConnector() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &PaymentsConnector{}
+	}
+}
+
+var SchemaPaymentsConnector = schema.Schema{
+	Description: "Data source reading the configuration of a Formance Payments Connector.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the connector.",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The name of the connector.",
+		},
+		"connector_provider": schema.StringAttribute{
+			Computed:    true,
+			Description: "The provider of the connector.",
+		},
+		"config": schema.DynamicAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The configuration of the connector as returned by the API. Secrets may be masked or omitted.",
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *PaymentsConnector) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaPaymentsConnector
+}
+
+// Metadata implements datasource.DataSource.
+func (d *PaymentsConnector) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_payments_connector"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *PaymentsConnector) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("payments")
+}
+
+// Read implements datasource.DataSource.
+func (d *PaymentsConnector) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config PaymentsConnectorModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.store.Payments().GetConnector(ctx, operations.V3GetConnectorConfigRequest{
+		ConnectorID: config.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	if err := config.fromConnectorConfig(&resp.V3GetConnectorConfigResponse.Data); err != nil {
+		res.Diagnostics.AddError(
+			"Invalid Connector Configuration",
+			fmt.Sprintf("Failed to read connector configuration from response: %v", err),
+		)
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
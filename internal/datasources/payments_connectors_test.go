@@ -0,0 +1,115 @@
+package datasources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentsConnectorsFilter(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	connectors := []shared.V3Connector{
+		{ID: "1", Name: "adyen-eu", Provider: "ADYEN", Reference: "ref-1", CreatedAt: createdAt},
+		{ID: "2", Name: "adyen-us", Provider: "ADYEN", Reference: "ref-2", CreatedAt: createdAt},
+		{ID: "3", Name: "stripe", Provider: "STRIPE", Reference: "ref-3", CreatedAt: createdAt, ScheduledForDeletion: true},
+	}
+
+	type testCase struct {
+		name        string
+		model       PaymentsConnectorsModel
+		expectedIDs []string
+	}
+
+	for _, tc := range []testCase{
+		{
+			name:        "no filter",
+			model:       PaymentsConnectorsModel{Provider: types.StringNull(), Name: types.StringNull()},
+			expectedIDs: []string{"1", "2", "3"},
+		},
+		{
+			name:        "provider case insensitive",
+			model:       PaymentsConnectorsModel{Provider: types.StringValue("Adyen"), Name: types.StringNull()},
+			expectedIDs: []string{"1", "2"},
+		},
+		{
+			name:        "provider and name",
+			model:       PaymentsConnectorsModel{Provider: types.StringValue("adyen"), Name: types.StringValue("adyen-us")},
+			expectedIDs: []string{"2"},
+		},
+		{
+			name:        "no match",
+			model:       PaymentsConnectorsModel{Provider: types.StringNull(), Name: types.StringValue("unknown")},
+			expectedIDs: []string{},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			items := tc.model.filterConnectors(connectors)
+			ids := []string{}
+			for _, item := range items {
+				ids = append(ids, item.ID.ValueString())
+			}
+			require.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+
+	items := PaymentsConnectorsModel{Provider: types.StringValue("stripe"), Name: types.StringNull()}.filterConnectors(connectors)
+	require.Equal(t, []PaymentsConnectorItem{{
+		ID:                   types.StringValue("3"),
+		Name:                 types.StringValue("stripe"),
+		Provider:             types.StringValue("STRIPE"),
+		Reference:            types.StringValue("ref-3"),
+		CreatedAt:            types.StringValue("2025-01-02T03:04:05Z"),
+		ScheduledForDeletion: types.BoolValue(true),
+	}}, items)
+}
+
+func TestPaymentsConnectorFromConnectorConfig(t *testing.T) {
+	t.Parallel()
+
+	var model PaymentsConnectorModel
+	err := model.fromConnectorConfig(&shared.V3InstallConnectorRequest{
+		Type: shared.V3InstallConnectorRequestTypeGeneric,
+		V3GenericConfig: &shared.V3GenericConfig{
+			APIKey:   "********",
+			Endpoint: "https://api.example.com",
+			Name:     "Example Connector",
+			Provider: pointer.For("Generic"),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.StringValue("Example Connector"), model.Name)
+	require.Equal(t, types.StringValue("Generic"), model.Provider)
+	require.False(t, model.Config.IsNull())
+}
+
+func TestPaymentsConnectorScheduleInstancesLastInstance(t *testing.T) {
+	t.Parallel()
+
+	first := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	var model PaymentsConnectorScheduleInstancesModel
+	model.fromInstances([]shared.V3Instance{
+		{ID: "second", CreatedAt: second, UpdatedAt: second, Error: pointer.For("rate limited")},
+		{ID: "first", CreatedAt: first, UpdatedAt: first, Terminated: true, TerminatedAt: pointer.For(first)},
+	})
+
+	require.Len(t, model.Instances, 2)
+	require.Equal(t, types.StringValue("2025-01-01T00:00:00Z"), model.Instances[1].TerminatedAt)
+	require.True(t, model.Instances[0].TerminatedAt.IsNull())
+	require.NotNil(t, model.LastInstance)
+	require.Equal(t, types.StringValue("second"), model.LastInstance.ID)
+	require.Equal(t, types.StringValue("rate limited"), model.LastInstance.Error)
+
+	model.fromInstances(nil)
+	require.Empty(t, model.Instances)
+	require.Nil(t, model.LastInstance)
+}
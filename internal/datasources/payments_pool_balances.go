@@ -0,0 +1,198 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                   = &PaymentsPoolBalances{}
+	_ datasource.DataSourceWithConfigure      = &PaymentsPoolBalances{}
+	_ datasource.DataSourceWithValidateConfig = &PaymentsPoolBalances{}
+)
+
+type PaymentsPoolBalances struct {
+	store *internal.ModuleStore
+}
+
+type PaymentsPoolBalancesModel struct {
+	PoolID   types.String                       `tfsdk:"pool_id"`
+	At       types.String                       `tfsdk:"at"`
+	Balances map[string]PaymentsPoolBalanceItem `tfsdk:"balances"`
+}
+
+type PaymentsPoolBalanceItem struct {
+	Amount          types.Number `tfsdk:"amount"`
+	RelatedAccounts []string     `tfsdk:"related_accounts"`
+}
+
+// fromBalances indexes the balances by asset. Balances reported several times for the same asset are summed.
+func (m *PaymentsPoolBalancesModel) fromBalances(balances []shared.V3PoolBalance) {
+	amounts := map[string]*big.Int{}
+	m.Balances = map[string]PaymentsPoolBalanceItem{}
+	for _, balance := range balances {
+		amount, ok := amounts[balance.Asset]
+		if !ok {
+			amount = new(big.Int)
+			amounts[balance.Asset] = amount
+		}
+		if balance.Amount != nil {
+			amount.Add(amount, balance.Amount)
+		}
+
+		item := m.Balances[balance.Asset]
+		if item.RelatedAccounts == nil {
+			item.RelatedAccounts = []string{}
+		}
+		item.RelatedAccounts = append(item.RelatedAccounts, balance.RelatedAccounts...)
+		item.Amount = types.NumberValue(new(big.Float).SetInt(amount))
+		m.Balances[balance.Asset] = item
+	}
+}
+
+func NewPaymentsPoolBalances() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &PaymentsPoolBalances{}
+	}
+}
+
+var SchemaPaymentsPoolBalances = schema.Schema{
+	Description: "Data source reading the per-asset balances of a Formance Payments Pool, either the latest ones or at a point in time.",
+	Attributes: map[string]schema.Attribute{
+		"pool_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the pool.",
+		},
+		"at": schema.StringAttribute{
+			Optional:    true,
+			Description: "The point in time of the balances, in RFC 3339 format. The latest balances are returned when unset.",
+		},
+		"balances": schema.MapNestedAttribute{
+			Computed:    true,
+			Description: "The balances of the pool, indexed by asset.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"amount": schema.NumberAttribute{
+						Computed:    true,
+						Description: "The balance amount, in the minor unit of the asset.",
+					},
+					"related_accounts": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "The accounts of the pool holding the asset.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *PaymentsPoolBalances) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaPaymentsPoolBalances
+}
+
+// Metadata implements datasource.DataSource.
+func (d *PaymentsPoolBalances) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_payments_pool_balances"
+}
+
+// ValidateConfig implements datasource.DataSourceWithValidateConfig.
+func (d *PaymentsPoolBalances) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, res *datasource.ValidateConfigResponse) {
+	var config PaymentsPoolBalancesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.At.IsNull() || config.At.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, config.At.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(
+			path.Root("at"),
+			"Invalid Date",
+			fmt.Sprintf("The at attribute must be a RFC 3339 date: %s", err),
+		)
+	}
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *PaymentsPoolBalances) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("payments")
+}
+
+// Read implements datasource.DataSource.
+func (d *PaymentsPoolBalances) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config PaymentsPoolBalancesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	sdkPayments := d.store.Payments()
+	var balances *shared.V3PoolBalancesResponse
+	if config.At.IsNull() {
+		resp, err := sdkPayments.GetPoolBalancesLatest(ctx, operations.V3GetPoolBalancesLatestRequest{
+			PoolID: config.PoolID.ValueString(),
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+		balances = resp.V3PoolBalancesResponse
+	} else {
+		at, err := time.Parse(time.RFC3339, config.At.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(
+				path.Root("at"),
+				"Invalid Date",
+				fmt.Sprintf("The at attribute must be a RFC 3339 date: %s", err),
+			)
+			return
+		}
+
+		resp, err := sdkPayments.GetPoolBalances(ctx, operations.V3GetPoolBalancesRequest{
+			PoolID: config.PoolID.ValueString(),
+			At:     &at,
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+		balances = resp.V3PoolBalancesResponse
+	}
+
+	config.fromBalances(balances.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
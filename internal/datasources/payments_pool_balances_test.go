@@ -0,0 +1,50 @@
+package datasources
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentsPoolBalancesFromBalances(t *testing.T) {
+	t.Parallel()
+
+	large, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	var model PaymentsPoolBalancesModel
+	model.fromBalances([]shared.V3PoolBalance{
+		{Asset: "USD/2", Amount: big.NewInt(100), RelatedAccounts: []string{"acc1"}},
+		{Asset: "USD/2", Amount: big.NewInt(50), RelatedAccounts: []string{"acc2"}},
+		{Asset: "EUR/2", Amount: large},
+		{Asset: "JPY/0"},
+	})
+
+	expected := map[string]PaymentsPoolBalanceItem{
+		"USD/2": {
+			Amount:          types.NumberValue(big.NewFloat(150)),
+			RelatedAccounts: []string{"acc1", "acc2"},
+		},
+		"EUR/2": {
+			Amount:          types.NumberValue(new(big.Float).SetInt(large)),
+			RelatedAccounts: []string{},
+		},
+		"JPY/0": {
+			Amount:          types.NumberValue(big.NewFloat(0)),
+			RelatedAccounts: []string{},
+		},
+	}
+	require.Len(t, model.Balances, len(expected))
+	for asset, item := range expected {
+		require.Contains(t, model.Balances, asset)
+		require.True(t, item.Amount.Equal(model.Balances[asset].Amount), "unexpected amount for %s: %s", asset, model.Balances[asset].Amount)
+		require.Equal(t, item.RelatedAccounts, model.Balances[asset].RelatedAccounts)
+	}
+
+	model.fromBalances(nil)
+	require.Empty(t, model.Balances)
+	require.NotNil(t, model.Balances)
+}
@@ -0,0 +1,196 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ReconciliationPolicies{}
+	_ datasource.DataSourceWithConfigure = &ReconciliationPolicies{}
+)
+
+type ReconciliationPolicies struct {
+	store *internal.ModuleStore
+}
+
+type ReconciliationPoliciesModel struct {
+	Name       types.String               `tfsdk:"name"`
+	LedgerName types.String               `tfsdk:"ledger_name"`
+	Policies   []ReconciliationPolicyItem `tfsdk:"policies"`
+}
+
+type ReconciliationPolicyItem struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	LedgerName     types.String `tfsdk:"ledger_name"`
+	PaymentsPoolID types.String `tfsdk:"payments_pool_id"`
+	LedgerQuery    types.String `tfsdk:"ledger_query"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// filterPolicies keeps the policies matching the name and ledger filters of the model.
+func (m ReconciliationPoliciesModel) filterPolicies(policies []shared.Policy) ([]ReconciliationPolicyItem, error) {
+	items := []ReconciliationPolicyItem{}
+	for _, policy := range policies {
+		if !m.Name.IsNull() && policy.Name != m.Name.ValueString() {
+			continue
+		}
+		if !m.LedgerName.IsNull() && policy.LedgerName != m.LedgerName.ValueString() {
+			continue
+		}
+
+		ledgerQuery := types.StringNull()
+		if len(policy.LedgerQuery) > 0 {
+			data, err := json.Marshal(policy.LedgerQuery)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal ledger query of policy %s: %w", policy.ID, err)
+			}
+			ledgerQuery = types.StringValue(string(data))
+		}
+
+		items = append(items, ReconciliationPolicyItem{
+			ID:             types.StringValue(policy.ID),
+			Name:           types.StringValue(policy.Name),
+			LedgerName:     types.StringValue(policy.LedgerName),
+			PaymentsPoolID: types.StringValue(policy.PaymentsPoolID),
+			LedgerQuery:    ledgerQuery,
+			CreatedAt:      types.StringValue(policy.CreatedAt.UTC().Format(time.RFC3339)),
+		})
+	}
+	return items, nil
+}
+
+func NewReconciliationPolicies() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &ReconciliationPolicies{}
+	}
+}
+
+var SchemaReconciliationPolicies = schema.Schema{
+	Description: "Data source listing the Formance Reconciliation Policies of the stack.",
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the policies with this name.",
+		},
+		"ledger_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the policies of this ledger.",
+		},
+		"policies": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The policies matching the filters.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the reconciliation policy.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the reconciliation policy.",
+					},
+					"ledger_name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the ledger associated with the reconciliation policy.",
+					},
+					"payments_pool_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the payments pool associated with the reconciliation policy.",
+					},
+					"ledger_query": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ledger query of the reconciliation policy, JSON encoded. Use `jsondecode` to read it.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The creation date of the reconciliation policy, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *ReconciliationPolicies) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaReconciliationPolicies
+}
+
+// Metadata implements datasource.DataSource.
+func (d *ReconciliationPolicies) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_reconciliation_policies"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *ReconciliationPolicies) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("reconciliation")
+}
+
+// Read implements datasource.DataSource.
+func (d *ReconciliationPolicies) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config ReconciliationPoliciesModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	sdkReconciliation := d.store.Reconciliation()
+	policies, err := sdk.Paginate(ctx, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.Policy], error) {
+		resp, err := sdkReconciliation.ListPolicies(ctx, operations.ListPoliciesRequest{
+			Cursor: cursor,
+		})
+		if err != nil {
+			return sdk.Cursor[shared.Policy]{}, err
+		}
+		cursorResp := resp.PoliciesCursorResponse.Cursor
+		return sdk.Cursor[shared.Policy]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Policies, err = config.filterPolicies(policies)
+	if err != nil {
+		res.Diagnostics.AddError(
+			"Invalid Reconciliation Policy",
+			fmt.Sprintf("Failed to read reconciliation policies from response: %v", err),
+		)
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
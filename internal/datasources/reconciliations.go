@@ -0,0 +1,343 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &Reconciliations{}
+	_ datasource.DataSourceWithConfigure = &Reconciliations{}
+	_ datasource.DataSource              = &Reconciliation{}
+	_ datasource.DataSourceWithConfigure = &Reconciliation{}
+)
+
+type ReconciliationItem struct {
+	ID                   types.String            `tfsdk:"id"`
+	PolicyID             types.String            `tfsdk:"policy_id"`
+	Status               types.String            `tfsdk:"status"`
+	Error                types.String            `tfsdk:"error"`
+	ReconciledAtLedger   types.String            `tfsdk:"reconciled_at_ledger"`
+	ReconciledAtPayments types.String            `tfsdk:"reconciled_at_payments"`
+	LedgerBalances       map[string]types.Number `tfsdk:"ledger_balances"`
+	PaymentsBalances     map[string]types.Number `tfsdk:"payments_balances"`
+	Drift                map[string]types.Number `tfsdk:"drift"`
+	CreatedAt            types.String            `tfsdk:"created_at"`
+}
+
+func newReconciliationItem(reconciliation shared.Reconciliation) ReconciliationItem {
+	return ReconciliationItem{
+		ID:                   types.StringValue(reconciliation.ID),
+		PolicyID:             types.StringValue(reconciliation.PolicyID),
+		Status:               types.StringValue(reconciliation.Status),
+		Error:                types.StringPointerValue(reconciliation.Error),
+		ReconciledAtLedger:   types.StringValue(reconciliation.ReconciledAtLedger.UTC().Format(time.RFC3339)),
+		ReconciledAtPayments: types.StringValue(reconciliation.ReconciledAtPayments.UTC().Format(time.RFC3339)),
+		LedgerBalances:       newAmounts(reconciliation.LedgerBalances),
+		PaymentsBalances:     newAmounts(reconciliation.PaymentsBalances),
+		Drift:                newAmounts(reconciliation.DriftBalances),
+		CreatedAt:            types.StringValue(reconciliation.CreatedAt.UTC().Format(time.RFC3339)),
+	}
+}
+
+// newAmounts converts amounts indexed by asset, a missing amount is reported as zero.
+func newAmounts(amounts map[string]*big.Int) map[string]types.Number {
+	values := make(map[string]types.Number, len(amounts))
+	for asset, amount := range amounts {
+		value := new(big.Float)
+		if amount != nil {
+			value.SetInt(amount)
+		}
+		values[asset] = types.NumberValue(value)
+	}
+	return values
+}
+
+var schemaReconciliationAttributes = map[string]schema.Attribute{
+	"policy_id": schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the reconciliation policy.",
+	},
+	"status": schema.StringAttribute{
+		Computed:    true,
+		Description: "The status of the reconciliation, `OK` when the ledger and payments balances match.",
+	},
+	"error": schema.StringAttribute{
+		Computed:    true,
+		Description: "The error reported by the reconciliation, if any.",
+	},
+	"reconciled_at_ledger": schema.StringAttribute{
+		Computed:    true,
+		Description: "The point in time of the ledger balances, in RFC 3339 format.",
+	},
+	"reconciled_at_payments": schema.StringAttribute{
+		Computed:    true,
+		Description: "The point in time of the payments pool balances, in RFC 3339 format.",
+	},
+	"ledger_balances": schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.NumberType,
+		Description: "The ledger balances, indexed by asset, in the minor unit of the asset.",
+	},
+	"payments_balances": schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.NumberType,
+		Description: "The payments pool balances, indexed by asset, in the minor unit of the asset.",
+	},
+	"drift": schema.MapAttribute{
+		Computed:    true,
+		ElementType: types.NumberType,
+		Description: "The drift between the ledger and payments balances, indexed by asset, in the minor unit of the asset.",
+	},
+	"created_at": schema.StringAttribute{
+		Computed:    true,
+		Description: "The creation date of the reconciliation, in RFC 3339 format.",
+	},
+}
+
+// reconciliationAttributes returns the reconciliation attributes along with the given id attribute.
+func reconciliationAttributes(id schema.StringAttribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": id,
+	}
+	for name, attribute := range schemaReconciliationAttributes {
+		attributes[name] = attribute
+	}
+	return attributes
+}
+
+type Reconciliations struct {
+	store *internal.ModuleStore
+}
+
+type ReconciliationsModel struct {
+	PolicyID           types.String         `tfsdk:"policy_id"`
+	Status             types.String         `tfsdk:"status"`
+	Limit              types.Int64          `tfsdk:"limit"`
+	Reconciliations    []ReconciliationItem `tfsdk:"reconciliations"`
+	LastReconciliation *ReconciliationItem  `tfsdk:"last_reconciliation"`
+}
+
+// matches reports whether the reconciliation matches the policy and status filters of the model.
+func (m ReconciliationsModel) matches(reconciliation shared.Reconciliation) bool {
+	return (m.PolicyID.IsNull() || reconciliation.PolicyID == m.PolicyID.ValueString()) &&
+		(m.Status.IsNull() || reconciliation.Status == m.Status.ValueString())
+}
+
+// fromReconciliations keeps the reconciliations matching the policy and status filters of the model,
+// and selects the most recently created one as the last reconciliation.
+func (m *ReconciliationsModel) fromReconciliations(reconciliations []shared.Reconciliation) {
+	m.Reconciliations = []ReconciliationItem{}
+	m.LastReconciliation = nil
+
+	var last *shared.Reconciliation
+	for i, reconciliation := range reconciliations {
+		if !m.matches(reconciliation) {
+			continue
+		}
+
+		m.Reconciliations = append(m.Reconciliations, newReconciliationItem(reconciliation))
+		if last == nil || reconciliation.CreatedAt.After(last.CreatedAt) {
+			last = &reconciliations[i]
+		}
+	}
+
+	if last != nil {
+		item := newReconciliationItem(*last)
+		m.LastReconciliation = &item
+	}
+}
+
+func NewReconciliations() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &Reconciliations{}
+	}
+}
+
+var SchemaReconciliations = schema.Schema{
+	Description: "Data source listing the Formance Reconciliations run on the stack.",
+	Attributes: map[string]schema.Attribute{
+		"policy_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the reconciliations of this policy.",
+		},
+		"status": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the reconciliations with this status.",
+		},
+		"limit": recentLimitAttribute,
+		"reconciliations": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The most recent reconciliations matching the filters, up to `limit`.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: reconciliationAttributes(schema.StringAttribute{
+					Computed:    true,
+					Description: "The unique identifier of the reconciliation.",
+				}),
+			},
+		},
+		"last_reconciliation": schema.SingleNestedAttribute{
+			Computed:    true,
+			Description: "The most recent reconciliation matching the filters, null if there is none.",
+			Attributes: reconciliationAttributes(schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the reconciliation.",
+			}),
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *Reconciliations) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaReconciliations
+}
+
+// Metadata implements datasource.DataSource.
+func (d *Reconciliations) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_reconciliations"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *Reconciliations) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("reconciliation")
+}
+
+// Read implements datasource.DataSource.
+func (d *Reconciliations) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config ReconciliationsModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The API lists the reconciliations most recently created first and cannot filter them,
+	// the filters are applied to each page so that only the pages holding the requested reconciliations are fetched.
+	limit := recentLimit(config.Limit)
+	sdkReconciliation := d.store.Reconciliation()
+	reconciliations, err := sdk.PaginateLimit(ctx, limit, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.Reconciliation], error) {
+		request := operations.ListReconciliationsRequest{
+			Cursor: cursor,
+		}
+		if cursor == nil {
+			request.PageSize = pointer.For(int64(min(limit, 100)))
+		}
+		resp, err := sdkReconciliation.ListReconciliations(ctx, request)
+		if err != nil {
+			return sdk.Cursor[shared.Reconciliation]{}, err
+		}
+		cursorResp := resp.ReconciliationsCursorResponse.Cursor
+		return sdk.Cursor[shared.Reconciliation]{
+			Data:    collectionutils.Filter(cursorResp.Data, config.matches),
+			HasMore: cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.fromReconciliations(reconciliations)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
+
+type Reconciliation struct {
+	store *internal.ModuleStore
+}
+
+func NewReconciliation() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &Reconciliation{}
+	}
+}
+
+var SchemaReconciliation = schema.Schema{
+	Description: "Data source reading a Formance Reconciliation.",
+	Attributes: reconciliationAttributes(schema.StringAttribute{
+		Required:    true,
+		Description: "The unique identifier of the reconciliation.",
+	}),
+}
+
+// Schema implements datasource.DataSource.
+func (d *Reconciliation) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaReconciliation
+}
+
+// Metadata implements datasource.DataSource.
+func (d *Reconciliation) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_reconciliation"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *Reconciliation) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("reconciliation")
+}
+
+// Read implements datasource.DataSource.
+func (d *Reconciliation) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config ReconciliationItem
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.store.Reconciliation().GetReconciliation(ctx, operations.GetReconciliationRequest{
+		ReconciliationID: config.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	item := newReconciliationItem(resp.ReconciliationResponse.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &item)...)
+}
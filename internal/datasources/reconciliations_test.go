@@ -0,0 +1,62 @@
+package datasources
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciliationPoliciesFilter(t *testing.T) {
+	t.Parallel()
+
+	policies := []shared.Policy{
+		{ID: "1", Name: "daily", LedgerName: "main", PaymentsPoolID: "pool"},
+		{ID: "2", Name: "weekly", LedgerName: "main", PaymentsPoolID: "pool", LedgerQuery: map[string]any{
+			"$match": map[string]any{"account": "bank"},
+		}},
+		{ID: "3", Name: "daily", LedgerName: "other", PaymentsPoolID: "pool"},
+	}
+
+	items, err := ReconciliationPoliciesModel{Name: types.StringValue("daily"), LedgerName: types.StringNull()}.filterPolicies(policies)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, "1", items[0].ID.ValueString())
+	require.Equal(t, "3", items[1].ID.ValueString())
+	require.True(t, items[0].LedgerQuery.IsNull())
+
+	items, err = ReconciliationPoliciesModel{Name: types.StringNull(), LedgerName: types.StringValue("main")}.filterPolicies(policies)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, `{"$match":{"account":"bank"}}`, items[1].LedgerQuery.ValueString())
+}
+
+func TestReconciliationsFilter(t *testing.T) {
+	t.Parallel()
+
+	reconciliations := []shared.Reconciliation{
+		{ID: "1", PolicyID: "a", Status: "OK", CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "2", PolicyID: "a", Status: "NOT_OK", CreatedAt: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), DriftBalances: map[string]*big.Int{
+			"USD/2": big.NewInt(-42),
+			"EUR/2": nil,
+		}},
+		{ID: "3", PolicyID: "b", Status: "OK", CreatedAt: time.Date(2025, 1, 4, 0, 0, 0, 0, time.UTC)},
+	}
+
+	model := ReconciliationsModel{PolicyID: types.StringValue("a"), Status: types.StringNull()}
+	model.fromReconciliations(reconciliations)
+	require.Len(t, model.Reconciliations, 2)
+	require.NotNil(t, model.LastReconciliation)
+	require.Equal(t, "2", model.LastReconciliation.ID.ValueString())
+	require.True(t, model.LastReconciliation.Drift["USD/2"].Equal(types.NumberValue(big.NewFloat(-42))))
+	require.True(t, model.LastReconciliation.Drift["EUR/2"].Equal(types.NumberValue(new(big.Float))))
+	require.Empty(t, model.LastReconciliation.LedgerBalances)
+
+	model = ReconciliationsModel{PolicyID: types.StringValue("b"), Status: types.StringValue("NOT_OK")}
+	model.fromReconciliations(reconciliations)
+	require.Empty(t, model.Reconciliations)
+	require.Nil(t, model.LastReconciliation)
+}
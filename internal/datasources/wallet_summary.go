@@ -0,0 +1,197 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &WalletSummary{}
+	_ datasource.DataSourceWithConfigure = &WalletSummary{}
+)
+
+type WalletSummary struct {
+	store *internal.ModuleStore
+}
+
+type WalletSummaryModel struct {
+	ID             types.String        `tfsdk:"id"`
+	AvailableFunds types.Map           `tfsdk:"available_funds"`
+	HoldFunds      types.Map           `tfsdk:"hold_funds"`
+	ExpiredFunds   types.Map           `tfsdk:"expired_funds"`
+	ExpirableFunds types.Map           `tfsdk:"expirable_funds"`
+	Balances       []WalletBalanceItem `tfsdk:"balances"`
+}
+
+type WalletBalanceItem struct {
+	Name      types.String `tfsdk:"name"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Assets    types.Map    `tfsdk:"assets"`
+}
+
+// walletFunds converts amounts indexed by asset into a map of numbers.
+func walletFunds(funds map[string]*big.Int) types.Map {
+	return types.MapValueMust(types.NumberType, collectionutils.ConvertMap(funds, func(amount *big.Int) attr.Value {
+		value := new(big.Float)
+		if amount != nil {
+			value.SetInt(amount)
+		}
+		return types.NumberValue(value)
+	}))
+}
+
+// fromSummary fills the model with the summary of the wallet, balances sorted by name.
+func (m *WalletSummaryModel) fromSummary(summary shared.WalletSummary) {
+	m.AvailableFunds = walletFunds(summary.AvailableFunds)
+	m.HoldFunds = walletFunds(summary.HoldFunds)
+	m.ExpiredFunds = walletFunds(summary.ExpiredFunds)
+	m.ExpirableFunds = walletFunds(summary.ExpirableFunds)
+
+	sort.SliceStable(summary.Balances, func(i, j int) bool {
+		return summary.Balances[i].Name < summary.Balances[j].Name
+	})
+	m.Balances = []WalletBalanceItem{}
+	for _, balance := range summary.Balances {
+		item := WalletBalanceItem{
+			Name:      types.StringValue(balance.Name),
+			ExpiresAt: types.StringNull(),
+			Priority:  types.Int64Null(),
+			Assets:    walletFunds(balance.Assets),
+		}
+		if balance.ExpiresAt != nil {
+			item.ExpiresAt = types.StringValue(balance.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+		if balance.Priority != nil {
+			item.Priority = types.Int64Value(balance.Priority.Int64())
+		}
+		m.Balances = append(m.Balances, item)
+	}
+}
+
+func NewWalletSummary() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &WalletSummary{}
+	}
+}
+
+var SchemaWalletSummary = schema.Schema{
+	Description: "Data source reading the totals of a Formance Wallet per asset, and the amounts of each of its balances. Amounts are in the minor unit of the asset.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the wallet.",
+		},
+		"available_funds": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.NumberType,
+			Description: "The funds which can be debited, indexed by asset.",
+		},
+		"hold_funds": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.NumberType,
+			Description: "The funds held by pending debits, indexed by asset.",
+		},
+		"expired_funds": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.NumberType,
+			Description: "The funds of the expired balances, indexed by asset.",
+		},
+		"expirable_funds": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.NumberType,
+			Description: "The funds of the balances with an expiry date which are not expired yet, indexed by asset.",
+		},
+		"balances": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The balances of the wallet, sorted by name.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the balance.",
+					},
+					"expires_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The expiry date of the balance, in RFC 3339 format.",
+					},
+					"priority": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The priority of the balance.",
+					},
+					"assets": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.NumberType,
+						Description: "The amounts of the balance, indexed by asset.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *WalletSummary) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaWalletSummary
+}
+
+// Metadata implements datasource.DataSource.
+func (d *WalletSummary) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_wallet_summary"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *WalletSummary) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("wallets")
+}
+
+// Read implements datasource.DataSource.
+func (d *WalletSummary) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config WalletSummaryModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := d.store.Wallets().GetWalletSummary(ctx, operations.GetWalletSummaryRequest{
+		ID: config.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.fromSummary(resp.GetWalletSummaryResponse.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,185 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &Wallets{}
+	_ datasource.DataSourceWithConfigure = &Wallets{}
+)
+
+type Wallets struct {
+	store *internal.ModuleStore
+}
+
+type WalletsModel struct {
+	Name     types.String `tfsdk:"name"`
+	Metadata types.Map    `tfsdk:"metadata"`
+	Wallets  []WalletItem `tfsdk:"wallets"`
+}
+
+type WalletItem struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Ledger    types.String `tfsdk:"ledger"`
+	Metadata  types.Map    `tfsdk:"metadata"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// listRequest converts the filters of the model into the request of the first page of wallets.
+func (m WalletsModel) listRequest() operations.ListWalletsRequest {
+	request := operations.ListWalletsRequest{
+		Name: m.Name.ValueStringPointer(),
+	}
+	if len(m.Metadata.Elements()) > 0 {
+		request.Metadata = collectionutils.ConvertMap(m.Metadata.Elements(), func(v attr.Value) string {
+			return v.(types.String).ValueString()
+		})
+	}
+	return request
+}
+
+func newWalletItem(wallet shared.Wallet) WalletItem {
+	return WalletItem{
+		ID:     types.StringValue(wallet.ID),
+		Name:   types.StringValue(wallet.Name),
+		Ledger: types.StringValue(wallet.Ledger),
+		Metadata: types.MapValueMust(types.StringType, collectionutils.ConvertMap(wallet.Metadata, func(v string) attr.Value {
+			return types.StringValue(v)
+		})),
+		CreatedAt: types.StringValue(wallet.CreatedAt.UTC().Format(time.RFC3339)),
+	}
+}
+
+func NewWallets() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &Wallets{}
+	}
+}
+
+var SchemaWallets = schema.Schema{
+	Description: "Data source listing the Formance Wallets of the stack, optionally filtered by name and metadata, such as the default wallet of a customer created by an application.",
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the wallets with this name.",
+		},
+		"metadata": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Only return the wallets holding all these metadata, such as `{ customer = \"42\" }`.",
+		},
+		"wallets": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The wallets matching the filters.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the wallet.",
+					},
+					"name": schema.StringAttribute{
+						Computed:    true,
+						Description: "The name of the wallet.",
+					},
+					"ledger": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ledger holding the accounts of the wallet.",
+					},
+					"metadata": schema.MapAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "The metadata of the wallet.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The creation date of the wallet, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *Wallets) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaWallets
+}
+
+// Metadata implements datasource.DataSource.
+func (d *Wallets) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_wallets"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *Wallets) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("wallets")
+}
+
+// Read implements datasource.DataSource.
+func (d *Wallets) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config WalletsModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The filters are carried by the cursor of the next pages
+	sdkWallets := d.store.Wallets()
+	wallets, err := sdk.Paginate(ctx, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.Wallet], error) {
+		request := operations.ListWalletsRequest{
+			Cursor: cursor,
+		}
+		if cursor == nil {
+			request = config.listRequest()
+		}
+		resp, err := sdkWallets.ListWallets(ctx, request)
+		if err != nil {
+			return sdk.Cursor[shared.Wallet]{}, err
+		}
+		cursorResp := resp.ListWalletsResponse.Cursor
+		return sdk.Cursor[shared.Wallet]{
+			Data:    cursorResp.Data,
+			HasMore: cursorResp.HasMore != nil && *cursorResp.HasMore,
+			Next:    cursorResp.Next,
+		}, nil
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Wallets = collectionutils.Map(wallets, newWalletItem)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,84 @@
+package datasources
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletsListRequest(t *testing.T) {
+	t.Parallel()
+
+	model := WalletsModel{
+		Name:     types.StringNull(),
+		Metadata: types.MapNull(types.StringType),
+	}
+	request := model.listRequest()
+	require.Nil(t, request.Name)
+	require.Nil(t, request.Metadata)
+
+	model = WalletsModel{
+		Name: types.StringValue("default"),
+		Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"customer": types.StringValue("42"),
+		}),
+	}
+	request = model.listRequest()
+	require.Equal(t, "default", *request.Name)
+	require.Equal(t, map[string]string{"customer": "42"}, request.Metadata)
+
+	item := newWalletItem(shared.Wallet{
+		ID:        "wallet",
+		Name:      "default",
+		Ledger:    "wallets-001",
+		CreatedAt: time.Date(2025, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+	})
+	require.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{}), item.Metadata)
+	require.Equal(t, "2025-01-01T00:00:00Z", item.CreatedAt.ValueString())
+}
+
+func TestWalletSummaryFromSummary(t *testing.T) {
+	t.Parallel()
+
+	large, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	var model WalletSummaryModel
+	model.fromSummary(shared.WalletSummary{
+		AvailableFunds: map[string]*big.Int{"USD/2": large},
+		HoldFunds:      map[string]*big.Int{"USD/2": big.NewInt(100)},
+		ExpiredFunds:   map[string]*big.Int{},
+		Balances: []shared.BalanceWithAssets{
+			{
+				Name:      "promo-2026",
+				ExpiresAt: pointer.For(time.Date(2027, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600))),
+				Priority:  big.NewInt(10),
+				Assets:    map[string]*big.Int{"USD/2": big.NewInt(500)},
+			},
+			{
+				Name:   "main",
+				Assets: map[string]*big.Int{"USD/2": nil},
+			},
+		},
+	})
+	require.True(t, types.NumberValue(new(big.Float).SetInt(large)).Equal(model.AvailableFunds.Elements()["USD/2"]))
+	require.True(t, types.NumberValue(big.NewFloat(100)).Equal(model.HoldFunds.Elements()["USD/2"]))
+	require.Empty(t, model.ExpiredFunds.Elements())
+	require.False(t, model.ExpirableFunds.IsNull())
+
+	require.Len(t, model.Balances, 2)
+	require.Equal(t, "main", model.Balances[0].Name.ValueString())
+	require.True(t, model.Balances[0].ExpiresAt.IsNull())
+	require.True(t, model.Balances[0].Priority.IsNull())
+	require.True(t, types.NumberValue(big.NewFloat(0)).Equal(model.Balances[0].Assets.Elements()["USD/2"]))
+	require.Equal(t, "promo-2026", model.Balances[1].Name.ValueString())
+	require.Equal(t, "2027-01-01T00:00:00Z", model.Balances[1].ExpiresAt.ValueString())
+	require.Equal(t, int64(10), model.Balances[1].Priority.ValueInt64())
+	require.True(t, types.NumberValue(big.NewFloat(500)).Equal(model.Balances[1].Assets.Elements()["USD/2"]))
+}
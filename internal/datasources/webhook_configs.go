@@ -0,0 +1,181 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &WebhookConfigs{}
+	_ datasource.DataSourceWithConfigure = &WebhookConfigs{}
+)
+
+type WebhookConfigs struct {
+	store *internal.ModuleStore
+}
+
+type WebhookConfigsModel struct {
+	Endpoint  types.String        `tfsdk:"endpoint"`
+	EventType types.String        `tfsdk:"event_type"`
+	Configs   []WebhookConfigItem `tfsdk:"configs"`
+}
+
+type WebhookConfigItem struct {
+	ID         types.String   `tfsdk:"id"`
+	Endpoint   types.String   `tfsdk:"endpoint"`
+	EventTypes []types.String `tfsdk:"event_types"`
+	Active     types.Bool     `tfsdk:"active"`
+	CreatedAt  types.String   `tfsdk:"created_at"`
+	UpdatedAt  types.String   `tfsdk:"updated_at"`
+}
+
+// filterConfigs keeps the configurations subscribed to the event type of the model, compared case-insensitively.
+// The endpoint filter is applied by the API.
+func (m WebhookConfigsModel) filterConfigs(configs []shared.WebhooksConfig) []WebhookConfigItem {
+	items := []WebhookConfigItem{}
+	for _, config := range configs {
+		if !m.EventType.IsNull() && !containsFold(config.EventTypes, m.EventType.ValueString()) {
+			continue
+		}
+
+		eventTypes := make([]types.String, 0, len(config.EventTypes))
+		for _, eventType := range config.EventTypes {
+			eventTypes = append(eventTypes, types.StringValue(eventType))
+		}
+		items = append(items, WebhookConfigItem{
+			ID:         types.StringValue(config.ID),
+			Endpoint:   types.StringValue(config.Endpoint),
+			EventTypes: eventTypes,
+			Active:     types.BoolValue(config.Active),
+			CreatedAt:  types.StringValue(config.CreatedAt.UTC().Format(time.RFC3339)),
+			UpdatedAt:  types.StringValue(config.UpdatedAt.UTC().Format(time.RFC3339)),
+		})
+	}
+	return items
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func NewWebhookConfigs() func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &WebhookConfigs{}
+	}
+}
+
+var SchemaWebhookConfigs = schema.Schema{
+	Description: "Data source listing the Formance Webhooks configurations of the stack. The API does not return the names of the configurations.",
+	Attributes: map[string]schema.Attribute{
+		"endpoint": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the configurations sending webhooks to this endpoint.",
+		},
+		"event_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "Only return the configurations subscribed to this event type, compared case-insensitively.",
+		},
+		"configs": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The configurations matching the filters, most recently updated first.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The unique identifier of the webhook configuration.",
+					},
+					"endpoint": schema.StringAttribute{
+						Computed:    true,
+						Description: "The endpoint to which webhooks are sent.",
+					},
+					"event_types": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "The types of events that trigger webhooks, lower-cased by the API.",
+					},
+					"active": schema.BoolAttribute{
+						Computed:    true,
+						Description: "Whether webhooks are sent to the endpoint.",
+					},
+					"created_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The creation date of the webhook configuration, in RFC 3339 format.",
+					},
+					"updated_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The last update date of the webhook configuration, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+	},
+}
+
+// Schema implements datasource.DataSource.
+func (d *WebhookConfigs) Schema(ctx context.Context, req datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	res.Schema = SchemaWebhookConfigs
+}
+
+// Metadata implements datasource.DataSource.
+func (d *WebhookConfigs) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_webhook_configs"
+}
+
+// Configure implements datasource.DataSourceWithConfigure.
+func (d *WebhookConfigs) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	d.store = store.NewModuleStore("webhooks")
+}
+
+// Read implements datasource.DataSource.
+func (d *WebhookConfigs) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	var config WebhookConfigsModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	d.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// The webhooks API returns every configuration at once, it does not paginate.
+	resp, err := d.store.Webhooks().GetManyConfigs(ctx, operations.GetManyConfigsRequest{
+		Endpoint: config.Endpoint.ValueStringPointer(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	config.Configs = config.filterConfigs(resp.ConfigsResponse.Cursor.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,30 @@
+package datasources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookConfigsFilter(t *testing.T) {
+	t.Parallel()
+
+	configs := []shared.WebhooksConfig{
+		{ID: "1", Endpoint: "https://example.com", EventTypes: []string{"ledger.committed_transactions"}, Active: true, CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "2", Endpoint: "https://example.com", EventTypes: []string{"ledger.saved_metadata", "payments.saved_payment"}},
+	}
+
+	items := WebhookConfigsModel{EventType: types.StringNull()}.filterConfigs(configs)
+	require.Len(t, items, 2)
+	require.Equal(t, "1", items[0].ID.ValueString())
+	require.True(t, items[0].Active.ValueBool())
+	require.Equal(t, "2025-01-01T00:00:00Z", items[0].CreatedAt.ValueString())
+
+	items = WebhookConfigsModel{EventType: types.StringValue("Payments.Saved_Payment")}.filterConfigs(configs)
+	require.Len(t, items, 1)
+	require.Equal(t, "2", items[0].ID.ValueString())
+	require.Len(t, items[0].EventTypes, 2)
+}
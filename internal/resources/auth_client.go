@@ -0,0 +1,396 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AuthClient{}
+	_ resource.ResourceWithConfigure   = &AuthClient{}
+	_ resource.ResourceWithModifyPlan  = &AuthClient{}
+	_ resource.ResourceWithImportState = &AuthClient{}
+)
+
+type AuthClient struct {
+	store *internal.ModuleStore
+}
+
+type AuthClientModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Scopes                 types.Set    `tfsdk:"scopes"`
+	RedirectURIs           types.List   `tfsdk:"redirect_uris"`
+	PostLogoutRedirectURIs types.List   `tfsdk:"post_logout_redirect_uris"`
+	Public                 types.Bool   `tfsdk:"public"`
+	Trusted                types.Bool   `tfsdk:"trusted"`
+	Metadata               types.Map    `tfsdk:"metadata"`
+}
+
+// stringElements returns the known string elements of a set or a list.
+func stringElements(elements []attr.Value) []string {
+	values := []string{}
+	for _, v := range elements {
+		if s, ok := v.(types.String); ok && !s.IsUnknown() && !s.IsNull() {
+			values = append(values, s.ValueString())
+		}
+	}
+	return values
+}
+
+func stringAttrValues(values []string) []attr.Value {
+	return collectionutils.Map(values, func(v string) attr.Value {
+		return types.StringValue(v)
+	})
+}
+
+// CreateConfig converts the model into the options of a client.
+func (m AuthClientModel) CreateConfig() shared.ClientOptions {
+	options := shared.ClientOptions{
+		Name:                   m.Name.ValueString(),
+		Description:            m.Description.ValueStringPointer(),
+		Scopes:                 stringElements(m.Scopes.Elements()),
+		RedirectUris:           stringElements(m.RedirectURIs.Elements()),
+		PostLogoutRedirectUris: stringElements(m.PostLogoutRedirectURIs.Elements()),
+		Public:                 m.Public.ValueBoolPointer(),
+		Trusted:                m.Trusted.ValueBoolPointer(),
+	}
+	if len(m.Metadata.Elements()) > 0 {
+		options.Metadata = collectionutils.ConvertMap(m.Metadata.Elements(), func(v attr.Value) string {
+			return v.(types.String).ValueString()
+		})
+	}
+	return options
+}
+
+// fromClient stores the client returned by the API in the model.
+// The API omits empty collections, they are kept null when they are not configured.
+func (m *AuthClientModel) fromClient(client *shared.Client) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil {
+		diags.AddError("Missing Auth Client", "The API did not return the client.")
+		return diags
+	}
+
+	m.ID = types.StringValue(client.ID)
+	m.Name = types.StringValue(client.Name)
+	// The API returns an empty description when none is set, it is kept null when not configured
+	if client.Description == nil || *client.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringPointerValue(client.Description)
+	}
+	m.Public = types.BoolValue(client.Public != nil && *client.Public)
+	m.Trusted = types.BoolValue(client.Trusted != nil && *client.Trusted)
+
+	if len(client.Scopes) > 0 || !m.Scopes.IsNull() {
+		m.Scopes = types.SetValueMust(types.StringType, stringAttrValues(client.Scopes))
+	}
+	if len(client.RedirectUris) > 0 || !m.RedirectURIs.IsNull() {
+		m.RedirectURIs = types.ListValueMust(types.StringType, stringAttrValues(client.RedirectUris))
+	}
+	if len(client.PostLogoutRedirectUris) > 0 || !m.PostLogoutRedirectURIs.IsNull() {
+		m.PostLogoutRedirectURIs = types.ListValueMust(types.StringType, stringAttrValues(client.PostLogoutRedirectUris))
+	}
+	if len(client.Metadata) > 0 || !m.Metadata.IsNull() {
+		m.Metadata = types.MapValueMust(types.StringType, collectionutils.ConvertMap(client.Metadata, func(v string) attr.Value {
+			return types.StringValue(v)
+		}))
+	}
+	return diags
+}
+
+// supportedScopes decodes the scopes advertised by the OpenID Connect discovery document of the stack.
+func supportedScopes(resp *operations.GetOIDCWellKnownsResponse) ([]string, error) {
+	if resp.RawResponse == nil || resp.RawResponse.Body == nil {
+		return nil, nil
+	}
+	defer resp.RawResponse.Body.Close()
+
+	var wellKnowns struct {
+		ScopesSupported []string `json:"scopes_supported"`
+	}
+	if err := json.NewDecoder(resp.RawResponse.Body).Decode(&wellKnowns); err != nil {
+		return nil, fmt.Errorf("failed to decode the OpenID Connect discovery document: %w", err)
+	}
+	return wellKnowns.ScopesSupported, nil
+}
+
+func NewAuthClient() func() resource.Resource {
+	return func() resource.Resource {
+		return &AuthClient{}
+	}
+}
+
+var SchemaAuthClient = schema.Schema{
+	Description: "Resource for managing an OAuth client of the Formance Auth module, such as the client of a backend service with least-privilege scopes. The secrets of the client are managed with the `stack_auth_client_secret` resource.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the client, used as the OAuth client ID.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the client.",
+		},
+		"description": schema.StringAttribute{
+			Optional:    true,
+			Description: "The description of the client.",
+		},
+		"scopes": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The scopes the client can request, such as `ledger:read`. New scopes are checked at plan time against the scopes advertised by the stack, the ones it does not advertise only produce warnings.",
+		},
+		"redirect_uris": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The URIs the authorization server can redirect to after a login.",
+		},
+		"post_logout_redirect_uris": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The URIs the authorization server can redirect to after a logout.",
+		},
+		"public": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the client is public, that is it cannot keep a secret such as a single page application. Defaults to `false`.",
+		},
+		"trusted": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the client is trusted, that is the user consent is not requested. Defaults to `false`.",
+		},
+		"metadata": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Metadata associated with the client, stored as key-value pairs.",
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *AuthClient) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaAuthClient
+}
+
+// Metadata implements resource.Resource.
+func (s *AuthClient) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_auth_client"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *AuthClient) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("auth")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// The scopes which are not in the state are checked against the scopes advertised by the stack.
+func (s *AuthClient) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state AuthClientModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	stateScopes := stringElements(state.Scopes.Elements())
+	scopes := []string{}
+	for _, scope := range stringElements(plan.Scopes.Elements()) {
+		if !slices.Contains(stateScopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) > 0 && s.store != nil {
+		s.checkScopes(ctx, scopes, &res.Diagnostics)
+	}
+}
+
+// checkScopes warns about the scopes which are not advertised by the stack.
+// The OpenID Connect discovery may only list the standard scopes, so unknown scopes are not errors.
+// Nothing is checked when the stack does not advertise its scopes.
+func (s *AuthClient) checkScopes(ctx context.Context, scopes []string, diagnostics *diag.Diagnostics) {
+	s.store.CheckModuleHealth(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Auth().GetOIDCWellKnowns(ctx)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+	supported, err := supportedScopes(resp)
+	if err != nil {
+		diagnostics.AddWarning("Invalid OpenID Connect Configuration", err.Error())
+		return
+	}
+	if len(supported) == 0 {
+		return
+	}
+
+	for _, scope := range scopes {
+		if slices.Contains(supported, scope) {
+			continue
+		}
+		diagnostics.AddAttributeWarning(
+			path.Root("scopes").AtSetValue(types.StringValue(scope)),
+			"Unknown Auth Scope",
+			fmt.Sprintf("The scope '%s' is not advertised by the stack.%s", scope, didYouMean(scope, supported)),
+		)
+	}
+}
+
+// Create implements resource.Resource.
+func (s *AuthClient) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan AuthClientModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	options := plan.CreateConfig()
+	resp, err := s.store.Auth().CreateClient(ctx, &options)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(plan.fromClient(resp.CreateClientResponse.Data)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read implements resource.Resource.
+func (s *AuthClient) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state AuthClientModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Auth().ReadClient(ctx, operations.ReadClientRequest{
+		ClientID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(state.fromClient(resp.ReadClientResponse.Data)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// Update implements resource.Resource.
+// The API replaces the options of the client with the planned ones, its secrets are kept.
+func (s *AuthClient) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan AuthClientModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	options := plan.CreateConfig()
+	resp, err := s.store.Auth().UpdateClient(ctx, operations.UpdateClientRequest{
+		ClientID:      plan.ID.ValueString(),
+		ClientOptions: &options,
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(plan.fromClient(resp.CreateClientResponse.Data)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (s *AuthClient) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	var state AuthClientModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.store.Auth().DeleteClient(ctx, operations.DeleteClientRequest{
+		ClientID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (s *AuthClient) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+}
@@ -0,0 +1,279 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &AuthClientSecret{}
+	_ resource.ResourceWithConfigure   = &AuthClientSecret{}
+	_ resource.ResourceWithImportState = &AuthClientSecret{}
+)
+
+type AuthClientSecret struct {
+	store *internal.ModuleStore
+}
+
+type AuthClientSecretModel struct {
+	ID              types.String `tfsdk:"id"`
+	ClientID        types.String `tfsdk:"client_id"`
+	Name            types.String `tfsdk:"name"`
+	Metadata        types.Map    `tfsdk:"metadata"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	Secret          types.String `tfsdk:"secret"`
+	LastDigits      types.String `tfsdk:"last_digits"`
+}
+
+// CreateConfig converts the model into a secret creation request.
+func (m AuthClientSecretModel) CreateConfig() operations.CreateSecretRequest {
+	secret := shared.CreateSecretRequest{
+		Name: m.Name.ValueString(),
+	}
+	if len(m.Metadata.Elements()) > 0 {
+		secret.Metadata = collectionutils.ConvertMap(m.Metadata.Elements(), func(v attr.Value) string {
+			return v.(types.String).ValueString()
+		})
+	}
+	return operations.CreateSecretRequest{
+		ClientID:            m.ClientID.ValueString(),
+		CreateSecretRequest: &secret,
+	}
+}
+
+// fromSecret stores the secret listed by its client in the model.
+// The API omits empty metadata, they are kept null when they are not configured.
+func (m *AuthClientSecretModel) fromSecret(id, name, lastDigits string, metadata map[string]string) {
+	m.ID = types.StringValue(id)
+	m.Name = types.StringValue(name)
+	m.LastDigits = types.StringValue(lastDigits)
+	if len(metadata) > 0 || !m.Metadata.IsNull() {
+		m.Metadata = types.MapValueMust(types.StringType, collectionutils.ConvertMap(metadata, func(v string) attr.Value {
+			return types.StringValue(v)
+		}))
+	}
+}
+
+func NewAuthClientSecret() func() resource.Resource {
+	return func() resource.Resource {
+		return &AuthClientSecret{}
+	}
+}
+
+var SchemaAuthClientSecret = schema.Schema{
+	Description: "Resource for managing a secret of an OAuth client of the Formance Auth module. The clear secret is only returned on creation, a secret cannot be updated and any change creates a new one. Set `create_before_destroy` in the `lifecycle` block and change `rotation_trigger` to rotate the secret without downtime.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the secret.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"client_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the client owning the secret.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the secret.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"metadata": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Metadata associated with the secret, stored as key-value pairs.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"rotation_trigger": schema.StringAttribute{
+			Optional:    true,
+			Description: "An arbitrary value, such as a date, whose changes create a new secret. It is not sent to the API.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"secret": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The clear secret, only known when the secret is created by Terraform. It is null for imported secrets.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"last_digits": schema.StringAttribute{
+			Computed:    true,
+			Description: "The last digits of the secret, to identify it without exposing it.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *AuthClientSecret) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaAuthClientSecret
+}
+
+// Metadata implements resource.Resource.
+func (s *AuthClientSecret) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_auth_client_secret"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *AuthClientSecret) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("auth")
+}
+
+// Create implements resource.Resource.
+func (s *AuthClientSecret) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan AuthClientSecretModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Auth().CreateSecret(ctx, plan.CreateConfig())
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	secret := resp.CreateSecretResponse.Data
+	if secret == nil {
+		res.Diagnostics.AddError("Missing Auth Client Secret", "The API did not return the secret.")
+		return
+	}
+	plan.fromSecret(secret.ID, secret.Name, secret.LastDigits, secret.Metadata)
+	plan.Secret = types.StringValue(secret.Clear)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read implements resource.Resource.
+// The API has no endpoint to read a secret, it is looked up in the secrets of its client.
+func (s *AuthClientSecret) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state AuthClientSecretModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Auth().ReadClient(ctx, operations.ReadClientRequest{
+		ClientID: state.ClientID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	client := resp.ReadClientResponse.Data
+	if client == nil {
+		res.Diagnostics.AddError("Missing Auth Client", "The API did not return the client.")
+		return
+	}
+	for _, secret := range client.Secrets {
+		if secret.ID == state.ID.ValueString() {
+			state.fromSecret(secret.ID, secret.Name, secret.LastDigits, secret.Metadata)
+			res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	// The secret was deleted outside of Terraform
+	res.State.RemoveResource(ctx)
+}
+
+// Update implements resource.Resource.
+// Every attribute sent to the API requires a new secret, only the state is updated.
+func (s *AuthClientSecret) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan AuthClientSecretModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (s *AuthClientSecret) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	var state AuthClientSecretModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.store.Auth().DeleteSecret(ctx, operations.DeleteSecretRequest{
+		ClientID: state.ClientID.ValueString(),
+		SecretID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+// Secrets are imported with the `<client_id>/<secret_id>` identifier, the clear secret cannot be recovered.
+func (s *AuthClientSecret) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	clientID, secretID, ok := strings.Cut(req.ID, "/")
+	if !ok || clientID == "" || secretID == "" {
+		res.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID must be in the <client_id>/<secret_id> format, got: %s", req.ID),
+		)
+		return
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), secretID)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("client_id"), clientID)...)
+}
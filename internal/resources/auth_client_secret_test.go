@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClientSecretCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := AuthClientSecretModel{
+		ClientID:        types.StringValue("client"),
+		Name:            types.StringValue("2026-10"),
+		Metadata:        types.MapNull(types.StringType),
+		RotationTrigger: types.StringValue("2026-10-19"),
+	}
+	require.Equal(t, operations.CreateSecretRequest{
+		ClientID: "client",
+		CreateSecretRequest: &shared.CreateSecretRequest{
+			Name: "2026-10",
+		},
+	}, model.CreateConfig())
+
+	model.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"owner": types.StringValue("payouts"),
+	})
+	require.Equal(t, map[string]string{"owner": "payouts"}, model.CreateConfig().CreateSecretRequest.Metadata)
+}
+
+func TestAuthClientSecretFromSecret(t *testing.T) {
+	t.Parallel()
+
+	model := AuthClientSecretModel{
+		Metadata: types.MapNull(types.StringType),
+	}
+	model.fromSecret("secret", "2026-10", "a1b2", nil)
+	require.Equal(t, "secret", model.ID.ValueString())
+	require.Equal(t, "2026-10", model.Name.ValueString())
+	require.Equal(t, "a1b2", model.LastDigits.ValueString())
+	require.True(t, model.Metadata.IsNull())
+
+	model.Metadata = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	model.fromSecret("secret", "2026-10", "a1b2", nil)
+	require.False(t, model.Metadata.IsNull())
+	require.Empty(t, model.Metadata.Elements())
+}
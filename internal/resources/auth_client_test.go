@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthClientCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := AuthClientModel{
+		Name:        types.StringValue("payouts"),
+		Description: types.StringNull(),
+		Scopes: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("ledger:read"),
+		}),
+		RedirectURIs:           types.ListNull(types.StringType),
+		PostLogoutRedirectURIs: types.ListNull(types.StringType),
+		Public:                 types.BoolValue(false),
+		Trusted:                types.BoolValue(true),
+		Metadata:               types.MapNull(types.StringType),
+	}
+	options := model.CreateConfig()
+	require.Equal(t, shared.ClientOptions{
+		Name:                   "payouts",
+		Scopes:                 []string{"ledger:read"},
+		RedirectUris:           []string{},
+		PostLogoutRedirectUris: []string{},
+		Public:                 pointer.For(false),
+		Trusted:                pointer.For(true),
+	}, options)
+}
+
+func TestAuthClientFromClient(t *testing.T) {
+	t.Parallel()
+
+	model := AuthClientModel{
+		Description:            types.StringNull(),
+		Scopes:                 types.SetNull(types.StringType),
+		RedirectURIs:           types.ListValueMust(types.StringType, []attr.Value{}),
+		PostLogoutRedirectURIs: types.ListNull(types.StringType),
+		Metadata:               types.MapNull(types.StringType),
+	}
+	diags := model.fromClient(&shared.Client{
+		ID:          "client",
+		Name:        "payouts",
+		Description: pointer.For(""),
+		Metadata:    map[string]string{"team": "payouts"},
+	})
+	require.False(t, diags.HasError())
+	require.Equal(t, "client", model.ID.ValueString())
+	// An empty description is kept null when not configured
+	require.True(t, model.Description.IsNull())
+	require.False(t, model.Public.ValueBool())
+	require.False(t, model.Trusted.ValueBool())
+	// Empty collections are kept as configured
+	require.True(t, model.Scopes.IsNull())
+	require.Equal(t, types.ListValueMust(types.StringType, []attr.Value{}), model.RedirectURIs)
+	require.True(t, model.PostLogoutRedirectURIs.IsNull())
+	require.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("payouts"),
+	}), model.Metadata)
+
+	require.True(t, model.fromClient(nil).HasError())
+}
+
+func TestAuthClientSupportedScopes(t *testing.T) {
+	t.Parallel()
+
+	scopes, err := supportedScopes(&operations.GetOIDCWellKnownsResponse{
+		RawResponse: &http.Response{
+			Body: io.NopCloser(strings.NewReader(`{"issuer": "https://example.com/api/auth", "scopes_supported": ["openid", "ledger:read"]}`)),
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"openid", "ledger:read"}, scopes)
+
+	_, err = supportedScopes(&operations.GetOIDCWellKnownsResponse{
+		RawResponse: &http.Response{
+			Body: io.NopCloser(strings.NewReader(`<html>`)),
+		},
+	})
+	require.Error(t, err)
+
+	scopes, err = supportedScopes(&operations.GetOIDCWellKnownsResponse{})
+	require.NoError(t, err)
+	require.Empty(t, scopes)
+}
@@ -0,0 +1,454 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &OrchestrationTrigger{}
+	_ resource.ResourceWithConfigure      = &OrchestrationTrigger{}
+	_ resource.ResourceWithValidateConfig = &OrchestrationTrigger{}
+	_ resource.ResourceWithModifyPlan     = &OrchestrationTrigger{}
+	_ resource.ResourceWithImportState    = &OrchestrationTrigger{}
+)
+
+type OrchestrationTrigger struct {
+	store *internal.ModuleStore
+}
+
+type OrchestrationTriggerModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	WorkflowID  types.String `tfsdk:"workflow_id"`
+	Event       types.String `tfsdk:"event"`
+	Filter      types.String `tfsdk:"filter"`
+	Vars        types.Map    `tfsdk:"vars"`
+	SampleEvent types.String `tfsdk:"sample_event"`
+	TestMatch   types.Bool   `tfsdk:"test_match"`
+	TestVars    types.Map    `tfsdk:"test_vars"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// CreateConfig converts the model into a trigger creation request.
+func (m OrchestrationTriggerModel) CreateConfig() shared.V2TriggerData {
+	data := shared.V2TriggerData{
+		Name:       m.Name.ValueStringPointer(),
+		WorkflowID: m.WorkflowID.ValueString(),
+		Event:      m.Event.ValueString(),
+		Filter:     m.Filter.ValueStringPointer(),
+	}
+	if len(m.Vars.Elements()) > 0 {
+		data.Vars = map[string]any{}
+		for key, value := range m.Vars.Elements() {
+			if s, ok := value.(types.String); ok {
+				data.Vars[key] = s.ValueString()
+			}
+		}
+	}
+	return data
+}
+
+// sampleEvent decodes the JSON encoded sample event of the model.
+func (m OrchestrationTriggerModel) sampleEvent() (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	decoder := json.NewDecoder(bytes.NewReader([]byte(m.SampleEvent.ValueString())))
+	decoder.UseNumber()
+	var event map[string]any
+	if err := decoder.Decode(&event); err != nil || event == nil {
+		if err == nil {
+			err = fmt.Errorf("the event is not an object")
+		}
+		diags.AddAttributeError(
+			path.Root("sample_event"),
+			"Invalid Sample Event",
+			fmt.Sprintf("The sample event must be a JSON object, use `jsonencode` to build it: %v", err),
+		)
+	}
+	return event, diags
+}
+
+// fromTrigger stores the trigger returned by the API in the model.
+func (m *OrchestrationTriggerModel) fromTrigger(trigger shared.V2Trigger) {
+	m.ID = types.StringValue(trigger.ID)
+	m.Name = types.StringPointerValue(trigger.Name)
+	m.WorkflowID = types.StringValue(trigger.WorkflowID)
+	m.Event = types.StringValue(trigger.Event)
+	m.Filter = types.StringPointerValue(trigger.Filter)
+	m.CreatedAt = types.StringValue(trigger.CreatedAt.UTC().Format(time.RFC3339))
+
+	if len(trigger.Vars) == 0 && len(m.Vars.Elements()) == 0 {
+		return
+	}
+	vars := make(map[string]attr.Value, len(trigger.Vars))
+	for key, value := range trigger.Vars {
+		if s, ok := value.(string); ok {
+			vars[key] = types.StringValue(s)
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			data = []byte(fmt.Sprint(value))
+		}
+		vars[key] = types.StringValue(string(data))
+	}
+	m.Vars = types.MapValueMust(types.StringType, vars)
+}
+
+// fromTest stores the result of a trigger test in the model.
+// Errors raised by the filter or the variables are reported on the sample event.
+func (m *OrchestrationTriggerModel) fromTest(test shared.V2TriggerTest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.TestMatch = types.BoolValue(true)
+	if test.Filter != nil {
+		if test.Filter.Error != nil && *test.Filter.Error != "" {
+			diags.AddAttributeError(
+				path.Root("sample_event"),
+				"Trigger Filter Failed",
+				fmt.Sprintf("The filter `%s` failed on the sample event: %s", m.Filter.ValueString(), *test.Filter.Error),
+			)
+		}
+		m.TestMatch = types.BoolValue(test.Filter.Match != nil && *test.Filter.Match)
+	}
+
+	vars := make(map[string]attr.Value, len(test.Variables))
+	for name, variable := range test.Variables {
+		if variable.Error != nil && *variable.Error != "" {
+			diags.AddAttributeError(
+				path.Root("sample_event"),
+				"Trigger Variable Failed",
+				fmt.Sprintf("The variable '%s' failed on the sample event: %s", name, *variable.Error),
+			)
+		}
+		vars[name] = types.StringPointerValue(variable.Value)
+	}
+	m.TestVars = types.MapValueMust(types.StringType, vars)
+	return diags
+}
+
+func NewOrchestrationTrigger() func() resource.Resource {
+	return func() resource.Resource {
+		return &OrchestrationTrigger{}
+	}
+}
+
+var SchemaOrchestrationTrigger = schema.Schema{
+	Description: "Resource for managing a Formance Orchestration Trigger, which runs a workflow when an event matching its filter is published. Triggers are immutable, any change but the sample event replaces the trigger. For advanced usage and configuration, see the [Flows documentation](https://docs.formance.com/flows/).",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the trigger.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The name of the trigger.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"workflow_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the workflow run by the trigger.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"event": schema.StringAttribute{
+			Required:    true,
+			Description: "The type of the events listened to, such as `SAVED_PAYMENT`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"filter": schema.StringAttribute{
+			Optional:    true,
+			Description: "The expression the event must match to run the workflow, such as `event.amount > 1000`. Every event runs the workflow if not set.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"vars": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The variables of the workflow, evaluated from the event, such as `{ amount = \"event.amount\" }`.",
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"sample_event": schema.StringAttribute{
+			Optional:    true,
+			Description: "A sample event the trigger is tested against, a JSON object built with `jsonencode`. The API can only test existing triggers: the test runs at plan time when only the sample event of an existing trigger changes, and new or replaced triggers are only tested on apply, once created. Filter or variable errors fail the plan, or the apply in which case the new trigger is deleted. The sample event is only used by the test.",
+		},
+		"test_match": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the sample event matches the filter of the trigger. Null without `sample_event`.",
+		},
+		"test_vars": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The variables of the workflow evaluated from the sample event. Null without `sample_event`.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The creation date of the trigger, in RFC 3339 format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *OrchestrationTrigger) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaOrchestrationTrigger
+}
+
+// Metadata implements resource.Resource.
+func (s *OrchestrationTrigger) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_orchestration_trigger"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *OrchestrationTrigger) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("orchestration")
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (s *OrchestrationTrigger) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var config OrchestrationTriggerModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SampleEvent.IsNull() || config.SampleEvent.IsUnknown() {
+		return
+	}
+	_, diags := config.sampleEvent()
+	res.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// A new sample event is tested against an existing trigger, so that broken filters are reported at plan time.
+// The API can only test existing triggers, new or replaced triggers are tested on apply, once created.
+func (s *OrchestrationTrigger) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan OrchestrationTriggerModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SampleEvent.IsNull() {
+		plan.TestMatch = types.BoolNull()
+		plan.TestVars = types.MapNull(types.StringType)
+		res.Diagnostics.Append(res.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	if req.State.Raw.IsNull() || plan.SampleEvent.IsUnknown() || s.store == nil {
+		return
+	}
+
+	var state OrchestrationTriggerModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if !plan.sameTrigger(state) {
+		return
+	}
+	if plan.SampleEvent.Equal(state.SampleEvent) && !state.TestMatch.IsNull() {
+		return
+	}
+
+	s.test(ctx, &plan, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.Plan.Set(ctx, &plan)...)
+}
+
+// sameTrigger reports whether the trigger of the model is the one of the state, that is it is not replaced.
+func (m OrchestrationTriggerModel) sameTrigger(state OrchestrationTriggerModel) bool {
+	return m.Name.Equal(state.Name) &&
+		m.WorkflowID.Equal(state.WorkflowID) &&
+		m.Event.Equal(state.Event) &&
+		m.Filter.Equal(state.Filter) &&
+		m.Vars.Equal(state.Vars)
+}
+
+// test runs the trigger of the model against its sample event and stores the result in the model.
+func (s *OrchestrationTrigger) test(ctx context.Context, m *OrchestrationTriggerModel, diagnostics *diag.Diagnostics) {
+	event, diags := m.sampleEvent()
+	diagnostics.Append(diags...)
+	if diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Orchestration().TestTrigger(ctx, operations.TestTriggerRequest{
+		TriggerID:   m.ID.ValueString(),
+		RequestBody: event,
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+
+	diagnostics.Append(m.fromTest(resp.V2TestTriggerResponse.Data)...)
+}
+
+// Create implements resource.Resource.
+func (s *OrchestrationTrigger) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan OrchestrationTriggerModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	config := plan.CreateConfig()
+	resp, err := s.store.Orchestration().CreateTrigger(ctx, &config)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	plan.fromTrigger(resp.V2CreateTriggerResponse.Data)
+	if plan.SampleEvent.IsNull() {
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// A trigger failing on its sample event is deleted, so that it does not run on the events of the stack.
+	s.test(ctx, &plan, &res.Diagnostics)
+	if !res.Diagnostics.HasError() {
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		return
+	}
+	if _, err := s.store.Orchestration().DeleteTrigger(ctx, operations.V2DeleteTriggerRequest{
+		TriggerID: plan.ID.ValueString(),
+	}); err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		plan.TestMatch = types.BoolNull()
+		plan.TestVars = types.MapNull(types.StringType)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+	}
+}
+
+// Read implements resource.Resource.
+// The test results are kept as is, the sample event is only tested when it changes.
+func (s *OrchestrationTrigger) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state OrchestrationTriggerModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Orchestration().ReadTrigger(ctx, operations.V2ReadTriggerRequest{
+		TriggerID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	state.fromTrigger(resp.V2ReadTriggerResponse.Data)
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// Update implements resource.Resource.
+// Only the sample event can change without replacing the trigger, it is tested unless it was tested at plan time.
+func (s *OrchestrationTrigger) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan OrchestrationTriggerModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TestMatch.IsUnknown() || plan.TestVars.IsUnknown() {
+		s.test(ctx, &plan, &res.Diagnostics)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (s *OrchestrationTrigger) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	var state OrchestrationTriggerModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.store.Orchestration().DeleteTrigger(ctx, operations.V2DeleteTriggerRequest{
+		TriggerID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (s *OrchestrationTrigger) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+}
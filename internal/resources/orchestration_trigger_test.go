@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrchestrationTriggerCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := OrchestrationTriggerModel{
+		Name:       types.StringNull(),
+		WorkflowID: types.StringValue("workflow"),
+		Event:      types.StringValue("SAVED_PAYMENT"),
+		Filter:     types.StringValue("event.amount > 1000"),
+		Vars: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"amount": types.StringValue("event.amount"),
+		}),
+		SampleEvent: types.StringValue(`{"amount": 100000000000000000000001}`),
+	}
+	config := model.CreateConfig()
+	require.Nil(t, config.Name)
+	require.Equal(t, "workflow", config.WorkflowID)
+	require.Equal(t, "event.amount > 1000", *config.Filter)
+	require.Equal(t, map[string]any{"amount": "event.amount"}, config.Vars)
+
+	// Large amounts of the sample event are not rounded
+	event, diags := model.sampleEvent()
+	require.False(t, diags.HasError())
+	require.Equal(t, map[string]any{"amount": json.Number("100000000000000000000001")}, event)
+
+	model.SampleEvent = types.StringValue(`["event"]`)
+	_, diags = model.sampleEvent()
+	require.True(t, diags.HasError())
+	model.SampleEvent = types.StringValue(`null`)
+	_, diags = model.sampleEvent()
+	require.True(t, diags.HasError())
+}
+
+func TestOrchestrationTriggerSameTrigger(t *testing.T) {
+	t.Parallel()
+
+	state := OrchestrationTriggerModel{
+		Name:        types.StringNull(),
+		WorkflowID:  types.StringValue("workflow"),
+		Event:       types.StringValue("SAVED_PAYMENT"),
+		Filter:      types.StringValue("event.amount > 1000"),
+		Vars:        types.MapNull(types.StringType),
+		SampleEvent: types.StringValue(`{"amount": 100}`),
+	}
+	plan := state
+	plan.SampleEvent = types.StringValue(`{"amount": 2000}`)
+	require.True(t, plan.sameTrigger(state))
+
+	// A new filter replaces the trigger, which can only be tested once created
+	plan.Filter = types.StringValue("event.amount > 10")
+	require.False(t, plan.sameTrigger(state))
+}
+
+func TestOrchestrationTriggerFromTrigger(t *testing.T) {
+	t.Parallel()
+
+	model := OrchestrationTriggerModel{
+		Vars: types.MapNull(types.StringType),
+	}
+	model.fromTrigger(shared.V2Trigger{
+		ID:         "trigger",
+		WorkflowID: "workflow",
+		Event:      "SAVED_PAYMENT",
+		CreatedAt:  time.Date(2025, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+	})
+	require.Equal(t, "trigger", model.ID.ValueString())
+	require.True(t, model.Name.IsNull())
+	require.True(t, model.Filter.IsNull())
+	require.True(t, model.Vars.IsNull())
+	require.Equal(t, "2025-01-01T00:00:00Z", model.CreatedAt.ValueString())
+
+	model.fromTrigger(shared.V2Trigger{
+		ID:    "trigger",
+		Event: "SAVED_PAYMENT",
+		Vars:  map[string]any{"amount": "event.amount", "count": float64(2)},
+	})
+	require.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"amount": types.StringValue("event.amount"),
+		"count":  types.StringValue("2"),
+	}), model.Vars)
+}
+
+func TestOrchestrationTriggerFromTest(t *testing.T) {
+	t.Parallel()
+
+	model := OrchestrationTriggerModel{
+		Filter: types.StringValue("event.amount > 1000"),
+	}
+	diags := model.fromTest(shared.V2TriggerTest{
+		Filter: &shared.Filter{Match: pointer.For(true)},
+		Variables: map[string]shared.Variables{
+			"amount": {Value: pointer.For("2000")},
+		},
+	})
+	require.False(t, diags.HasError())
+	require.True(t, model.TestMatch.ValueBool())
+	require.Equal(t, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"amount": types.StringValue("2000"),
+	}), model.TestVars)
+
+	diags = model.fromTest(shared.V2TriggerTest{
+		Filter: &shared.Filter{Error: pointer.For("unknown field amout")},
+		Variables: map[string]shared.Variables{
+			"amount": {Error: pointer.For("unknown field amout")},
+		},
+	})
+	require.Equal(t, 2, diags.ErrorsCount())
+	require.False(t, model.TestMatch.ValueBool())
+	require.Equal(t, "The filter `event.amount > 1000` failed on the sample event: unknown field amout", diags[0].Detail())
+
+	// Without a filter, every event matches
+	diags = model.fromTest(shared.V2TriggerTest{})
+	require.False(t, diags.HasError())
+	require.True(t, model.TestMatch.ValueBool())
+}
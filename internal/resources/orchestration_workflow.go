@@ -0,0 +1,402 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &OrchestrationWorkflow{}
+	_ resource.ResourceWithConfigure      = &OrchestrationWorkflow{}
+	_ resource.ResourceWithValidateConfig = &OrchestrationWorkflow{}
+	_ resource.ResourceWithImportState    = &OrchestrationWorkflow{}
+)
+
+type OrchestrationWorkflow struct {
+	store *internal.ModuleStore
+}
+
+type OrchestrationWorkflowModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Stages    types.List   `tfsdk:"stages"`
+	Stage     types.List   `tfsdk:"stage"`
+	Variables types.Set    `tfsdk:"variables"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// CreateConfig converts the stages of the model, typed or JSON encoded, into a workflow configuration.
+// The variable references of the stages are checked against the variables, when they are declared.
+func (m OrchestrationWorkflowModel) CreateConfig(ctx context.Context) (shared.V2WorkflowConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := shared.V2WorkflowConfig{
+		Name:   m.Name.ValueStringPointer(),
+		Stages: []map[string]any{},
+	}
+
+	hasStages := !m.Stages.IsNull() && (m.Stages.IsUnknown() || len(m.Stages.Elements()) > 0)
+	hasStage := !m.Stage.IsNull() && (m.Stage.IsUnknown() || len(m.Stage.Elements()) > 0)
+	if hasStages == hasStage {
+		diags.AddAttributeError(
+			path.Root("stages"),
+			"Invalid Workflow Stages",
+			"Exactly one of `stages` or `stage` blocks must be set.",
+		)
+		return config, diags
+	}
+
+	variables := []string{}
+	for _, v := range m.Variables.Elements() {
+		if s, ok := v.(types.String); ok {
+			variables = append(variables, s.ValueString())
+		}
+	}
+	checkReferences := !m.Variables.IsNull() && !m.Variables.IsUnknown()
+
+	for i, element := range m.Stage.Elements() {
+		stagePath := path.Root("stage").AtListIndex(i)
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		var stage WorkflowStageModel
+		if !objectAs(ctx, object, &stage, &diags) {
+			continue
+		}
+		wire, stageDiags := stage.toWire(ctx, stagePath)
+		diags.Append(stageDiags...)
+		if wire == nil {
+			continue
+		}
+		if checkReferences {
+			checkVariables(wire, variables, stagePath, &diags)
+		}
+		config.Stages = append(config.Stages, wire)
+	}
+
+	for i, element := range m.Stages.Elements() {
+		stage, ok := element.(types.String)
+		if !ok || stage.IsUnknown() || stage.IsNull() {
+			continue
+		}
+
+		stagePath := path.Root("stages").AtListIndex(i)
+		decoded, err := decodeStage([]byte(stage.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(
+				stagePath,
+				"Invalid Workflow Stage",
+				fmt.Sprintf("The stage must be a JSON object, such as `jsonencode({ delay = { duration = \"1h\" } })`: %v", err),
+			)
+			continue
+		}
+		if checkReferences {
+			checkVariables(decoded, variables, stagePath, &diags)
+		}
+		config.Stages = append(config.Stages, decoded)
+	}
+	return config, diags
+}
+
+// fromWorkflow stores the workflow returned by the API in the model.
+// Stages equivalent to the ones of the model are kept as is, so that their formatting does not produce a diff.
+// Typed stages are kept typed as long as the API returns stages they can represent.
+func (m *OrchestrationWorkflowModel) fromWorkflow(ctx context.Context, workflow shared.V2Workflow) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.ID = types.StringValue(workflow.ID)
+	m.Name = types.StringPointerValue(workflow.Config.Name)
+	m.CreatedAt = types.StringValue(workflow.CreatedAt.UTC().Format(time.RFC3339))
+
+	if len(m.Stage.Elements()) > 0 {
+		if m.sameTypedStages(ctx, workflow.Config.Stages) {
+			return diags
+		}
+		if stages, ok := typedStagesFromWire(ctx, workflow.Config.Stages); ok {
+			m.Stage = stages
+			return diags
+		}
+	}
+	m.Stage = types.ListValueMust(workflowStageType, []attr.Value{})
+
+	previous := m.Stages.Elements()
+
+	stages := make([]attr.Value, 0, len(workflow.Config.Stages))
+	for i, stage := range workflow.Config.Stages {
+		if i < len(previous) {
+			if s, ok := previous[i].(types.String); ok {
+				var decoded map[string]any
+				if err := json.Unmarshal([]byte(s.ValueString()), &decoded); err == nil && sameStage(decoded, stage) {
+					stages = append(stages, s)
+					continue
+				}
+			}
+		}
+
+		data, err := json.Marshal(stage)
+		if err != nil {
+			diags.AddError("Invalid Workflow Stage", fmt.Sprintf("Failed to encode stage %d of workflow %s: %v", i, workflow.ID, err))
+			return diags
+		}
+		stages = append(stages, types.StringValue(string(data)))
+	}
+	m.Stages = types.ListValueMust(types.StringType, stages)
+	return diags
+}
+
+// sameTypedStages reports whether the typed stages of the model convert to the given stages.
+func (m OrchestrationWorkflowModel) sameTypedStages(ctx context.Context, stages []map[string]any) bool {
+	elements := m.Stage.Elements()
+	if len(elements) != len(stages) {
+		return false
+	}
+	for i, element := range elements {
+		var diags diag.Diagnostics
+		var stage WorkflowStageModel
+		object, ok := element.(types.Object)
+		if !ok || !objectAs(ctx, object, &stage, &diags) {
+			return false
+		}
+		wire, diags := stage.toWire(ctx, path.Root("stage").AtListIndex(i))
+		if diags.HasError() || wire == nil {
+			return false
+		}
+		if !sameStage(wire, stages[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func typedStagesFromWire(ctx context.Context, stages []map[string]any) (types.List, bool) {
+	typed := make([]attr.Value, 0, len(stages))
+	for _, stage := range stages {
+		object, ok := stageFromWire(ctx, stage)
+		if !ok {
+			return types.List{}, false
+		}
+		typed = append(typed, object)
+	}
+	return types.ListValueMust(workflowStageType, typed), true
+}
+
+// sameStage compares two stages by their JSON semantics, so that number representations do not matter.
+func sameStage(left, right map[string]any) bool {
+	l, err := normalizeJSON(left)
+	if err != nil {
+		return false
+	}
+	r, err := normalizeJSON(right)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(l, r)
+}
+
+func NewOrchestrationWorkflow() func() resource.Resource {
+	return func() resource.Resource {
+		return &OrchestrationWorkflow{}
+	}
+}
+
+var SchemaOrchestrationWorkflow = schema.Schema{
+	Description: "Resource for managing a Formance Orchestration Workflow. Workflows are immutable, any change replaces the workflow. For advanced usage and configuration, see the [Flows documentation](https://docs.formance.com/flows/).",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the workflow.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The name of the workflow.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"stages": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The stages of the workflow, run in order. Each stage is a JSON object, use `jsonencode` to build it, such as `jsonencode({ delay = { duration = \"1h\" } })`. Prefer the typed `stage` blocks, conflicts with them. Imported workflows use this attribute.",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+		"variables": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The names of the variables the workflow expects when run. Stages reference them as `${name}`, written `$${name}` in HCL strings. When set, references to undeclared variables are rejected at plan time. The variables are not sent to the API.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The creation date of the workflow, in RFC 3339 format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"stage": schema.ListNestedBlock{
+			Description:  "The typed stages of the workflow, run in order. Each stage has exactly one of `send`, `delay` or `wait_event`. Conflicts with `stages`.",
+			NestedObject: schemaWorkflowStage,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
+			},
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *OrchestrationWorkflow) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaOrchestrationWorkflow
+}
+
+// Metadata implements resource.Resource.
+func (s *OrchestrationWorkflow) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_orchestration_workflow"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *OrchestrationWorkflow) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("orchestration")
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (s *OrchestrationWorkflow) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var config OrchestrationWorkflowModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := config.CreateConfig(ctx)
+	res.Diagnostics.Append(diags...)
+}
+
+// Create implements resource.Resource.
+func (s *OrchestrationWorkflow) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan OrchestrationWorkflowModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := plan.CreateConfig(ctx)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Orchestration().CreateWorkflow(ctx, &config)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(plan.fromWorkflow(ctx, resp.V2CreateWorkflowResponse.Data)...)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read implements resource.Resource.
+func (s *OrchestrationWorkflow) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state OrchestrationWorkflowModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Orchestration().GetWorkflow(ctx, operations.V2GetWorkflowRequest{
+		FlowID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(state.fromWorkflow(ctx, resp.V2GetWorkflowResponse.Data)...)
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// Update implements resource.Resource.
+// Every attribute requires a replacement, so there is nothing to update.
+func (s *OrchestrationWorkflow) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan OrchestrationWorkflowModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+func (s *OrchestrationWorkflow) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	var state OrchestrationWorkflowModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.store.Orchestration().DeleteWorkflow(ctx, operations.V2DeleteWorkflowRequest{
+		FlowID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (s *OrchestrationWorkflow) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+}
@@ -0,0 +1,661 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The typed stages are decoded level by level from objects, so that dynamic blocks and unknown
+// values can be checked at plan time.
+type WorkflowStageModel struct {
+	Send      types.Object `tfsdk:"send"`
+	Delay     types.Object `tfsdk:"delay"`
+	WaitEvent types.Object `tfsdk:"wait_event"`
+}
+
+type WorkflowSendModel struct {
+	Amount      types.String `tfsdk:"amount"`
+	Asset       types.String `tfsdk:"asset"`
+	Metadata    types.Map    `tfsdk:"metadata"`
+	Timestamp   types.String `tfsdk:"timestamp"`
+	Source      types.Object `tfsdk:"source"`
+	Destination types.Object `tfsdk:"destination"`
+}
+
+type WorkflowSendSourceModel struct {
+	Account types.Object `tfsdk:"account"`
+	Wallet  types.Object `tfsdk:"wallet"`
+	Payment types.Object `tfsdk:"payment"`
+}
+
+type WorkflowSendDestinationModel struct {
+	Account types.Object `tfsdk:"account"`
+	Wallet  types.Object `tfsdk:"wallet"`
+	Payment types.Object `tfsdk:"payment"`
+}
+
+type WorkflowAccountModel struct {
+	ID     types.String `tfsdk:"id"`
+	Ledger types.String `tfsdk:"ledger"`
+}
+
+type WorkflowWalletModel struct {
+	ID      types.String `tfsdk:"id"`
+	Balance types.String `tfsdk:"balance"`
+}
+
+type WorkflowSourcePaymentModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+type WorkflowDestinationPaymentModel struct {
+	PSP types.String `tfsdk:"psp"`
+}
+
+type WorkflowDelayModel struct {
+	Duration types.String `tfsdk:"duration"`
+	Until    types.String `tfsdk:"until"`
+}
+
+type WorkflowWaitEventModel struct {
+	Event types.String `tfsdk:"event"`
+}
+
+func newWorkflowStageModel() WorkflowStageModel {
+	return WorkflowStageModel{
+		Send:      types.ObjectNull(workflowSendType.AttrTypes),
+		Delay:     types.ObjectNull(workflowDelayType.AttrTypes),
+		WaitEvent: types.ObjectNull(workflowWaitEventType.AttrTypes),
+	}
+}
+
+func newWorkflowSendSourceModel() WorkflowSendSourceModel {
+	return WorkflowSendSourceModel{
+		Account: types.ObjectNull(workflowAccountType.AttrTypes),
+		Wallet:  types.ObjectNull(workflowWalletType.AttrTypes),
+		Payment: types.ObjectNull(workflowSourcePaymentType.AttrTypes),
+	}
+}
+
+func newWorkflowSendDestinationModel() WorkflowSendDestinationModel {
+	return WorkflowSendDestinationModel{
+		Account: types.ObjectNull(workflowAccountType.AttrTypes),
+		Wallet:  types.ObjectNull(workflowWalletType.AttrTypes),
+		Payment: types.ObjectNull(workflowDestinationPaymentType.AttrTypes),
+	}
+}
+
+var schemaWorkflowAccount = schema.SingleNestedBlock{
+	Description: "A ledger account.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required:    true,
+			Description: "The address of the account.",
+		},
+		"ledger": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ledger of the account, the default ledger of the stack if not set.",
+		},
+	},
+}
+
+var schemaWorkflowWallet = schema.SingleNestedBlock{
+	Description: "A wallet.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the wallet.",
+		},
+		"balance": schema.StringAttribute{
+			Optional:    true,
+			Description: "The balance of the wallet, the main balance if not set.",
+		},
+	},
+}
+
+var schemaWorkflowStage = schema.NestedBlockObject{
+	Blocks: map[string]schema.Block{
+		"send": schema.SingleNestedBlock{
+			Description: "Sends an amount from a source to a destination.",
+			Attributes: map[string]schema.Attribute{
+				"amount": schema.StringAttribute{
+					Optional:    true,
+					Description: "The amount to send, as an integer in the minor unit of the asset or a variable reference such as `${amount}`, written `$${amount}` in HCL strings. Required.",
+				},
+				"asset": schema.StringAttribute{
+					Optional:    true,
+					Description: "The asset to send, such as `USD/2`. Required.",
+				},
+				"metadata": schema.MapAttribute{
+					Optional:    true,
+					ElementType: types.StringType,
+					Description: "The metadata of the transfer.",
+				},
+				"timestamp": schema.StringAttribute{
+					Optional:    true,
+					Description: "The date of the transfer, in RFC 3339 format.",
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"source": schema.SingleNestedBlock{
+					Description: "The source of the funds, exactly one of `account`, `wallet` or `payment`.",
+					Blocks: map[string]schema.Block{
+						"account": schemaWorkflowAccount,
+						"wallet":  schemaWorkflowWallet,
+						"payment": schema.SingleNestedBlock{
+							Description: "A payment.",
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required:    true,
+									Description: "The ID of the payment.",
+								},
+							},
+						},
+					},
+				},
+				"destination": schema.SingleNestedBlock{
+					Description: "The destination of the funds, exactly one of `account`, `wallet` or `payment`.",
+					Blocks: map[string]schema.Block{
+						"account": schemaWorkflowAccount,
+						"wallet":  schemaWorkflowWallet,
+						"payment": schema.SingleNestedBlock{
+							Description: "A payout through a payment service provider.",
+							Attributes: map[string]schema.Attribute{
+								"psp": schema.StringAttribute{
+									Required:    true,
+									Description: "The payment service provider.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"delay": schema.SingleNestedBlock{
+			Description: "Waits for a duration or until a date, exactly one of `duration` or `until`.",
+			Attributes: map[string]schema.Attribute{
+				"duration": schema.StringAttribute{
+					Optional:    true,
+					Description: "The duration to wait, such as `1h`.",
+				},
+				"until": schema.StringAttribute{
+					Optional:    true,
+					Description: "The date to wait for, in RFC 3339 format.",
+				},
+			},
+		},
+		"wait_event": schema.SingleNestedBlock{
+			Description: "Waits for an event sent to the workflow instance.",
+			Attributes: map[string]schema.Attribute{
+				"event": schema.StringAttribute{
+					Optional:    true,
+					Description: "The name of the event. Required.",
+				},
+			},
+		},
+	},
+}
+
+var (
+	workflowStageType              = schemaWorkflowStage.Type().(types.ObjectType)
+	workflowSendType               = workflowStageType.AttrTypes["send"].(types.ObjectType)
+	workflowDelayType              = workflowStageType.AttrTypes["delay"].(types.ObjectType)
+	workflowWaitEventType          = workflowStageType.AttrTypes["wait_event"].(types.ObjectType)
+	workflowSourceType             = workflowSendType.AttrTypes["source"].(types.ObjectType)
+	workflowDestinationType        = workflowSendType.AttrTypes["destination"].(types.ObjectType)
+	workflowAccountType            = schemaWorkflowAccount.Type().(types.ObjectType)
+	workflowWalletType             = schemaWorkflowWallet.Type().(types.ObjectType)
+	workflowSourcePaymentType      = workflowSourceType.AttrTypes["payment"].(types.ObjectType)
+	workflowDestinationPaymentType = workflowDestinationType.AttrTypes["payment"].(types.ObjectType)
+)
+
+var amountReference = regexp.MustCompile(`^\$\{[^}]*\}$`)
+
+// toWire converts the stage to the format of the API, checking that it is complete.
+// Unknown values are skipped so that the stage can be checked before they are known.
+func (m WorkflowStageModel) toWire(ctx context.Context, stagePath path.Path) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var key string
+	var value any
+
+	kinds := 0
+	if !m.Send.IsNull() {
+		kinds++
+		key = "send"
+		var send WorkflowSendModel
+		if objectAs(ctx, m.Send, &send, &diags) {
+			value = send.toWire(ctx, stagePath.AtName("send"), &diags)
+		}
+	}
+	if !m.Delay.IsNull() {
+		kinds++
+		key = "delay"
+		var delay WorkflowDelayModel
+		if objectAs(ctx, m.Delay, &delay, &diags) {
+			value = delay.toWire(stagePath.AtName("delay"), &diags)
+		}
+	}
+	if !m.WaitEvent.IsNull() {
+		kinds++
+		key = "wait_event"
+		var waitEvent WorkflowWaitEventModel
+		if objectAs(ctx, m.WaitEvent, &waitEvent, &diags) {
+			if waitEvent.Event.IsNull() {
+				diags.AddAttributeError(stagePath.AtName("wait_event").AtName("event"), "Invalid Workflow Stage", "The event to wait for is required.")
+			}
+			value = shared.V2StageWaitEvent{Event: waitEvent.Event.ValueString()}
+		}
+	}
+	if kinds != 1 {
+		diags.AddAttributeError(stagePath, "Invalid Workflow Stage", "A stage must have exactly one of `send`, `delay` or `wait_event`.")
+	}
+	if diags.HasError() || value == nil {
+		return nil, diags
+	}
+
+	wire, err := encodeStage(value)
+	if err != nil {
+		diags.AddAttributeError(stagePath, "Invalid Workflow Stage", fmt.Sprintf("Failed to encode the stage: %v", err))
+		return nil, diags
+	}
+	return map[string]any{key: wire}, diags
+}
+
+// toWire converts the send stage, its amount is kept as a string when it references a variable.
+func (m WorkflowSendModel) toWire(ctx context.Context, sendPath path.Path, diags *diag.Diagnostics) map[string]any {
+	send := shared.V2StageSend{}
+	reference := ""
+
+	if m.Amount.IsNull() || m.Asset.IsNull() {
+		diags.AddAttributeError(sendPath, "Invalid Workflow Stage", "The `amount` and `asset` to send are required.")
+	} else if !m.Amount.IsUnknown() {
+		amount, ok := new(big.Int).SetString(m.Amount.ValueString(), 10)
+		if amountReference.MatchString(m.Amount.ValueString()) {
+			reference = m.Amount.ValueString()
+			amount, ok = new(big.Int), true
+		}
+		if !ok {
+			diags.AddAttributeError(sendPath.AtName("amount"), "Invalid Workflow Stage", "The amount must be an integer, in the minor unit of the asset, or a variable reference such as `${amount}`.")
+		}
+		send.Amount = &shared.V2Monetary{Amount: amount, Asset: m.Asset.ValueString()}
+	}
+
+	if !m.Metadata.IsNull() && !m.Metadata.IsUnknown() {
+		send.Metadata = map[string]string{}
+		for key, value := range m.Metadata.Elements() {
+			if s, ok := value.(types.String); ok {
+				send.Metadata[key] = s.ValueString()
+			}
+		}
+	}
+
+	if !m.Timestamp.IsNull() && !m.Timestamp.IsUnknown() {
+		timestamp, err := time.Parse(time.RFC3339, m.Timestamp.ValueString())
+		if err != nil {
+			diags.AddAttributeError(sendPath.AtName("timestamp"), "Invalid Workflow Stage", fmt.Sprintf("The timestamp must be in RFC 3339 format: %v", err))
+		}
+		timestamp = timestamp.UTC()
+		send.Timestamp = &timestamp
+	}
+
+	var source WorkflowSendSourceModel
+	if m.Source.IsNull() {
+		diags.AddAttributeError(sendPath, "Invalid Workflow Stage", "The `source` of the funds is required.")
+	} else if objectAs(ctx, m.Source, &source, diags) {
+		kinds := 0
+		send.Source = &shared.V2StageSendSource{}
+		if !source.Account.IsNull() {
+			kinds++
+			var account WorkflowAccountModel
+			objectAs(ctx, source.Account, &account, diags)
+			send.Source.Account = account.toWire()
+		}
+		if !source.Wallet.IsNull() {
+			kinds++
+			var wallet WorkflowWalletModel
+			objectAs(ctx, source.Wallet, &wallet, diags)
+			send.Source.Wallet = wallet.toWire()
+		}
+		if !source.Payment.IsNull() {
+			kinds++
+			var payment WorkflowSourcePaymentModel
+			objectAs(ctx, source.Payment, &payment, diags)
+			send.Source.Payment = &shared.V2StageSendSourcePayment{ID: payment.ID.ValueString()}
+		}
+		if kinds != 1 {
+			diags.AddAttributeError(sendPath.AtName("source"), "Invalid Workflow Stage", "The source must have exactly one of `account`, `wallet` or `payment`.")
+		}
+	}
+
+	var destination WorkflowSendDestinationModel
+	if m.Destination.IsNull() {
+		diags.AddAttributeError(sendPath, "Invalid Workflow Stage", "The `destination` of the funds is required.")
+	} else if objectAs(ctx, m.Destination, &destination, diags) {
+		kinds := 0
+		send.Destination = &shared.V2StageSendDestination{}
+		if !destination.Account.IsNull() {
+			kinds++
+			var account WorkflowAccountModel
+			objectAs(ctx, destination.Account, &account, diags)
+			send.Destination.Account = account.toWire()
+		}
+		if !destination.Wallet.IsNull() {
+			kinds++
+			var wallet WorkflowWalletModel
+			objectAs(ctx, destination.Wallet, &wallet, diags)
+			send.Destination.Wallet = wallet.toWire()
+		}
+		if !destination.Payment.IsNull() {
+			kinds++
+			var payment WorkflowDestinationPaymentModel
+			objectAs(ctx, destination.Payment, &payment, diags)
+			send.Destination.Payment = &shared.V2StageSendDestinationPayment{Psp: payment.PSP.ValueString()}
+		}
+		if kinds != 1 {
+			diags.AddAttributeError(sendPath.AtName("destination"), "Invalid Workflow Stage", "The destination must have exactly one of `account`, `wallet` or `payment`.")
+		}
+	}
+
+	wire, err := encodeStage(send)
+	if err != nil {
+		diags.AddAttributeError(sendPath, "Invalid Workflow Stage", fmt.Sprintf("Failed to encode the stage: %v", err))
+		return nil
+	}
+	if monetary, ok := wire["amount"].(map[string]any); ok && reference != "" {
+		monetary["amount"] = reference
+	}
+	return wire
+}
+
+func (m WorkflowAccountModel) toWire() *shared.V2StageSendDestinationAccount {
+	return &shared.V2StageSendDestinationAccount{
+		ID:     m.ID.ValueString(),
+		Ledger: m.Ledger.ValueStringPointer(),
+	}
+}
+
+func (m WorkflowWalletModel) toWire() *shared.V2StageSendDestinationWallet {
+	return &shared.V2StageSendDestinationWallet{
+		ID:      m.ID.ValueString(),
+		Balance: m.Balance.ValueStringPointer(),
+	}
+}
+
+func (m WorkflowDelayModel) toWire(delayPath path.Path, diags *diag.Diagnostics) shared.V2StageDelay {
+	delay := shared.V2StageDelay{
+		Duration: m.Duration.ValueStringPointer(),
+	}
+	if m.Duration.IsNull() == m.Until.IsNull() {
+		diags.AddAttributeError(delayPath, "Invalid Workflow Stage", "A delay must have exactly one of `duration` or `until`.")
+	}
+	if !m.Until.IsNull() && !m.Until.IsUnknown() {
+		until, err := time.Parse(time.RFC3339, m.Until.ValueString())
+		if err != nil {
+			diags.AddAttributeError(delayPath.AtName("until"), "Invalid Workflow Stage", fmt.Sprintf("The date must be in RFC 3339 format: %v", err))
+		}
+		until = until.UTC()
+		delay.Until = &until
+	}
+	return delay
+}
+
+// objectAs decodes a block into its model. It returns false when the block is null or unknown,
+// in which case it cannot be checked yet.
+func objectAs(ctx context.Context, object types.Object, target any, diags *diag.Diagnostics) bool {
+	if object.IsNull() || object.IsUnknown() {
+		return false
+	}
+	objectDiags := object.As(ctx, target, basetypes.ObjectAsOptions{})
+	diags.Append(objectDiags...)
+	return !objectDiags.HasError()
+}
+
+// objectFrom converts a model into a block of the given type.
+func objectFrom(ctx context.Context, objectType types.ObjectType, model any, diags *diag.Diagnostics) types.Object {
+	object, objectDiags := types.ObjectValueFrom(ctx, objectType.AttrTypes, model)
+	diags.Append(objectDiags...)
+	return object
+}
+
+// stageFromWire converts a stage returned by the API to its typed form.
+// It returns false when the stage cannot be represented by the typed blocks.
+func stageFromWire(ctx context.Context, stage map[string]any) (types.Object, bool) {
+	var diags diag.Diagnostics
+	if len(stage) != 1 {
+		return types.Object{}, false
+	}
+
+	model := newWorkflowStageModel()
+	for key, value := range stage {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return types.Object{}, false
+		}
+
+		switch key {
+		case "send":
+			send, ok := sendFromWire(ctx, data)
+			if !ok {
+				return types.Object{}, false
+			}
+			model.Send = send
+		case "delay":
+			var delay shared.V2StageDelay
+			if err := json.Unmarshal(data, &delay); err != nil {
+				return types.Object{}, false
+			}
+			until := types.StringNull()
+			if delay.Until != nil {
+				until = types.StringValue(delay.Until.UTC().Format(time.RFC3339))
+			}
+			model.Delay = objectFrom(ctx, workflowDelayType, WorkflowDelayModel{
+				Duration: types.StringPointerValue(delay.Duration),
+				Until:    until,
+			}, &diags)
+		case "wait_event":
+			var waitEvent shared.V2StageWaitEvent
+			if err := json.Unmarshal(data, &waitEvent); err != nil {
+				return types.Object{}, false
+			}
+			model.WaitEvent = objectFrom(ctx, workflowWaitEventType, WorkflowWaitEventModel{
+				Event: types.StringValue(waitEvent.Event),
+			}, &diags)
+		default:
+			return types.Object{}, false
+		}
+	}
+
+	object := objectFrom(ctx, workflowStageType, model, &diags)
+	return object, !diags.HasError()
+}
+
+// sendFromWire converts a send stage returned by the API to its typed form.
+// Its amount is decoded apart, as it is either an integer or a variable reference.
+func sendFromWire(ctx context.Context, data []byte) (types.Object, bool) {
+	var diags diag.Diagnostics
+	wire, err := decodeStage(data)
+	if err != nil {
+		return types.Object{}, false
+	}
+	monetary, ok := wire["amount"].(map[string]any)
+	if !ok {
+		return types.Object{}, false
+	}
+	var amount string
+	switch value := monetary["amount"].(type) {
+	case json.Number:
+		if _, ok := new(big.Int).SetString(value.String(), 10); !ok {
+			return types.Object{}, false
+		}
+		amount = value.String()
+	case string:
+		if !amountReference.MatchString(value) {
+			return types.Object{}, false
+		}
+		amount = value
+		monetary["amount"] = json.Number("0")
+	default:
+		return types.Object{}, false
+	}
+
+	data, err = json.Marshal(wire)
+	if err != nil {
+		return types.Object{}, false
+	}
+	var send shared.V2StageSend
+	if err := json.Unmarshal(data, &send); err != nil {
+		return types.Object{}, false
+	}
+	if send.Source == nil || send.Destination == nil {
+		return types.Object{}, false
+	}
+
+	model := WorkflowSendModel{
+		Amount:    types.StringValue(amount),
+		Asset:     types.StringValue(send.Amount.Asset),
+		Metadata:  types.MapNull(types.StringType),
+		Timestamp: types.StringNull(),
+	}
+	if len(send.Metadata) > 0 {
+		metadata := make(map[string]attr.Value, len(send.Metadata))
+		for key, value := range send.Metadata {
+			metadata[key] = types.StringValue(value)
+		}
+		model.Metadata = types.MapValueMust(types.StringType, metadata)
+	}
+	if send.Timestamp != nil {
+		model.Timestamp = types.StringValue(send.Timestamp.UTC().Format(time.RFC3339))
+	}
+
+	source := newWorkflowSendSourceModel()
+	switch {
+	case send.Source.Account != nil:
+		source.Account = objectFrom(ctx, workflowAccountType, accountFromWire(*send.Source.Account), &diags)
+	case send.Source.Wallet != nil:
+		source.Wallet = objectFrom(ctx, workflowWalletType, walletFromWire(*send.Source.Wallet), &diags)
+	case send.Source.Payment != nil:
+		source.Payment = objectFrom(ctx, workflowSourcePaymentType, WorkflowSourcePaymentModel{
+			ID: types.StringValue(send.Source.Payment.ID),
+		}, &diags)
+	default:
+		return types.Object{}, false
+	}
+	model.Source = objectFrom(ctx, workflowSourceType, source, &diags)
+
+	destination := newWorkflowSendDestinationModel()
+	switch {
+	case send.Destination.Account != nil:
+		destination.Account = objectFrom(ctx, workflowAccountType, accountFromWire(*send.Destination.Account), &diags)
+	case send.Destination.Wallet != nil:
+		destination.Wallet = objectFrom(ctx, workflowWalletType, walletFromWire(*send.Destination.Wallet), &diags)
+	case send.Destination.Payment != nil:
+		destination.Payment = objectFrom(ctx, workflowDestinationPaymentType, WorkflowDestinationPaymentModel{
+			PSP: types.StringValue(send.Destination.Payment.Psp),
+		}, &diags)
+	default:
+		return types.Object{}, false
+	}
+	model.Destination = objectFrom(ctx, workflowDestinationType, destination, &diags)
+
+	object := objectFrom(ctx, workflowSendType, model, &diags)
+	return object, !diags.HasError()
+}
+
+func accountFromWire(account shared.V2StageSendDestinationAccount) WorkflowAccountModel {
+	return WorkflowAccountModel{
+		ID:     types.StringValue(account.ID),
+		Ledger: types.StringPointerValue(account.Ledger),
+	}
+}
+
+func walletFromWire(wallet shared.V2StageSendDestinationWallet) WorkflowWalletModel {
+	return WorkflowWalletModel{
+		ID:      types.StringValue(wallet.ID),
+		Balance: types.StringPointerValue(wallet.Balance),
+	}
+}
+
+// encodeStage converts a stage to its JSON object form, keeping its numbers as is.
+func encodeStage(value any) (map[string]any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return decodeStage(data)
+}
+
+// decodeStage decodes a JSON stage, keeping its numbers as is so that large amounts are not rounded.
+func decodeStage(data []byte) (map[string]any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var stage map[string]any
+	if err := decoder.Decode(&stage); err != nil {
+		return nil, err
+	}
+	if stage == nil {
+		return nil, fmt.Errorf("the stage is not an object")
+	}
+	return stage, nil
+}
+
+var variableReference = regexp.MustCompile(`\$\{([^}]*)\}`)
+var variableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// checkVariables validates the `${var}` references of the stage against the declared variables.
+func checkVariables(stage any, variables []string, stagePath path.Path, diagnostics *diag.Diagnostics) {
+	switch value := stage.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			checkVariables(value[key], variables, stagePath, diagnostics)
+		}
+	case []any:
+		for _, item := range value {
+			checkVariables(item, variables, stagePath, diagnostics)
+		}
+	case string:
+		for _, match := range variableReference.FindAllStringSubmatch(value, -1) {
+			name := match[1]
+			switch {
+			case !variableName.MatchString(name):
+				diagnostics.AddAttributeError(stagePath, "Invalid Variable Reference",
+					fmt.Sprintf("The reference '%s' is not a valid variable name.", match[0]))
+			case !containsString(variables, name):
+				diagnostics.AddAttributeError(stagePath, "Undeclared Variable",
+					fmt.Sprintf("The variable '%s' is not declared in `variables`.%s", name, didYouMean(name, variables)))
+			}
+		}
+		if strings.Count(value, "${") > len(variableReference.FindAllString(value, -1)) {
+			diagnostics.AddAttributeError(stagePath, "Invalid Variable Reference",
+				fmt.Sprintf("The value '%s' has an unterminated variable reference.", value))
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
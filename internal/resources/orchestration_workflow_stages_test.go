@@ -0,0 +1,195 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func mustObject(t *testing.T, objectType types.ObjectType, model any) types.Object {
+	object, diags := types.ObjectValueFrom(context.Background(), objectType.AttrTypes, model)
+	require.False(t, diags.HasError(), diags)
+	return object
+}
+
+func newSendModel(t *testing.T) WorkflowSendModel {
+	source := newWorkflowSendSourceModel()
+	source.Account = mustObject(t, workflowAccountType, WorkflowAccountModel{ID: types.StringValue("world"), Ledger: types.StringValue("main")})
+	destination := newWorkflowSendDestinationModel()
+	destination.Wallet = mustObject(t, workflowWalletType, WorkflowWalletModel{ID: types.StringValue("${wallet}"), Balance: types.StringNull()})
+
+	return WorkflowSendModel{
+		Amount:      types.StringValue("100"),
+		Asset:       types.StringValue("USD/2"),
+		Metadata:    types.MapValueMust(types.StringType, map[string]attr.Value{"reason": types.StringValue("${reason}")}),
+		Timestamp:   types.StringNull(),
+		Source:      mustObject(t, workflowSourceType, source),
+		Destination: mustObject(t, workflowDestinationType, destination),
+	}
+}
+
+func newSendStage(t *testing.T, send WorkflowSendModel) attr.Value {
+	stage := newWorkflowStageModel()
+	stage.Send = mustObject(t, workflowSendType, send)
+	return mustObject(t, workflowStageType, stage)
+}
+
+func newDelayStage(t *testing.T, delay WorkflowDelayModel) attr.Value {
+	stage := newWorkflowStageModel()
+	stage.Delay = mustObject(t, workflowDelayType, delay)
+	return mustObject(t, workflowStageType, stage)
+}
+
+func newWaitEventStage(t *testing.T, event string) attr.Value {
+	stage := newWorkflowStageModel()
+	stage.WaitEvent = mustObject(t, workflowWaitEventType, WorkflowWaitEventModel{Event: types.StringValue(event)})
+	return mustObject(t, workflowStageType, stage)
+}
+
+func newStages(stages ...attr.Value) types.List {
+	return types.ListValueMust(workflowStageType, stages)
+}
+
+func TestOrchestrationWorkflowTypedStages(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	model := OrchestrationWorkflowModel{
+		Name:   types.StringNull(),
+		Stages: types.ListNull(types.StringType),
+		Stage: newStages(
+			newSendStage(t, newSendModel(t)),
+			newDelayStage(t, WorkflowDelayModel{Duration: types.StringNull(), Until: types.StringValue("2025-01-01T02:00:00+02:00")}),
+			newWaitEventStage(t, "confirmed"),
+		),
+		Variables: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("wallet"),
+			types.StringValue("reason"),
+		}),
+	}
+	config, diags := model.CreateConfig(ctx)
+	require.False(t, diags.HasError(), diags)
+
+	data, err := json.Marshal(config.Stages)
+	require.NoError(t, err)
+	require.JSONEq(t, `[
+		{"send": {
+			"amount": {"amount": 100, "asset": "USD/2"},
+			"metadata": {"reason": "${reason}"},
+			"source": {"account": {"id": "world", "ledger": "main"}},
+			"destination": {"wallet": {"id": "${wallet}"}}
+		}},
+		{"delay": {"until": "2025-01-01T00:00:00Z"}},
+		{"wait_event": {"event": "confirmed"}}
+	]`, string(data))
+
+	stages, ok := typedStagesFromWire(ctx, config.Stages)
+	require.True(t, ok)
+	require.Len(t, stages.Elements(), 3)
+	require.True(t, stages.Elements()[0].Equal(newSendStage(t, newSendModel(t))))
+	require.True(t, stages.Elements()[1].Equal(newDelayStage(t, WorkflowDelayModel{Duration: types.StringNull(), Until: types.StringValue("2025-01-01T00:00:00Z")})))
+	require.True(t, stages.Elements()[2].Equal(newWaitEventStage(t, "confirmed")))
+
+	// Equivalent stages returned by the API keep the typed stages of the model as is
+	require.True(t, model.sameTypedStages(ctx, config.Stages))
+	_, ok = typedStagesFromWire(ctx, []map[string]any{{"update": map[string]any{}}})
+	require.False(t, ok)
+}
+
+func TestOrchestrationWorkflowVariableAmount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	send := newSendModel(t)
+	send.Amount = types.StringValue("${amount}")
+	model := OrchestrationWorkflowModel{
+		Stages: types.ListNull(types.StringType),
+		Stage:  newStages(newSendStage(t, send)),
+		Variables: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("amount"),
+			types.StringValue("wallet"),
+			types.StringValue("reason"),
+		}),
+	}
+	config, diags := model.CreateConfig(ctx)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "${amount}", config.Stages[0]["send"].(map[string]any)["amount"].(map[string]any)["amount"])
+
+	stages, ok := typedStagesFromWire(ctx, config.Stages)
+	require.True(t, ok)
+	require.True(t, stages.Elements()[0].Equal(newSendStage(t, send)))
+}
+
+func TestOrchestrationWorkflowUnknownStages(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	send := newSendModel(t)
+	send.Amount = types.StringUnknown()
+	send.Destination = types.ObjectUnknown(workflowDestinationType.AttrTypes)
+	model := OrchestrationWorkflowModel{
+		Stages:    types.ListNull(types.StringType),
+		Stage:     newStages(newSendStage(t, send), types.ObjectUnknown(workflowStageType.AttrTypes)),
+		Variables: types.SetNull(types.StringType),
+	}
+	_, diags := model.CreateConfig(ctx)
+	require.False(t, diags.HasError(), diags)
+
+	// Dynamic blocks are unknown until their for_each is known
+	model.Stage = types.ListUnknown(workflowStageType)
+	_, diags = model.CreateConfig(ctx)
+	require.False(t, diags.HasError(), diags)
+}
+
+func TestOrchestrationWorkflowInvalidStages(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	invalidSend := newSendModel(t)
+	invalidSend.Amount = types.StringValue("1.5")
+	destination := newWorkflowSendDestinationModel()
+	destination.Wallet = mustObject(t, workflowWalletType, WorkflowWalletModel{ID: types.StringValue("wallet"), Balance: types.StringNull()})
+	destination.Payment = mustObject(t, workflowDestinationPaymentType, WorkflowDestinationPaymentModel{PSP: types.StringValue("stripe")})
+	invalidSend.Destination = mustObject(t, workflowDestinationType, destination)
+
+	model := OrchestrationWorkflowModel{
+		Stages: types.ListNull(types.StringType),
+		Stage: newStages(
+			newSendStage(t, invalidSend),
+			newDelayStage(t, WorkflowDelayModel{Duration: types.StringNull(), Until: types.StringNull()}),
+			mustObject(t, workflowStageType, newWorkflowStageModel()),
+		),
+		Variables: types.SetNull(types.StringType),
+	}
+	_, diags := model.CreateConfig(ctx)
+	// Non integer amount, two destinations, empty delay and empty stage
+	require.Equal(t, 4, diags.ErrorsCount(), diags)
+
+	model.Stage = newStages(newSendStage(t, newSendModel(t)))
+	model.Variables = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("walet")})
+	_, diags = model.CreateConfig(ctx)
+	require.Equal(t, 2, diags.ErrorsCount(), diags)
+	require.Equal(t, "The variable 'wallet' is not declared in `variables`. Did you mean 'walet'?", diags[0].Detail())
+	require.Equal(t, "The variable 'reason' is not declared in `variables`.", diags[1].Detail())
+
+	// References are only checked when variables are declared
+	model.Variables = types.SetNull(types.StringType)
+	_, diags = model.CreateConfig(ctx)
+	require.False(t, diags.HasError(), diags)
+
+	model.Stage = types.ListNull(workflowStageType)
+	model.Stages = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`{"wait_event": {"event": "${event"}}`),
+	})
+	model.Variables = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("event")})
+	_, diags = model.CreateConfig(ctx)
+	require.Equal(t, 1, diags.ErrorsCount(), diags)
+
+	model.Stage = newStages(newSendStage(t, newSendModel(t)))
+	_, diags = model.CreateConfig(ctx)
+	require.Equal(t, "Exactly one of `stages` or `stage` blocks must be set.", diags[0].Detail())
+}
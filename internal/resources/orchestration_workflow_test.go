@@ -0,0 +1,69 @@
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrchestrationWorkflowCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := OrchestrationWorkflowModel{
+		Name: types.StringValue("payout"),
+		Stages: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue(`{"delay":{"duration":"1h"}}`),
+			types.StringValue(`{"wait_event":{"event":"confirmed"}}`),
+		}),
+	}
+	config, diags := model.CreateConfig(context.Background())
+	require.False(t, diags.HasError())
+	require.Equal(t, "payout", *config.Name)
+	require.Equal(t, []map[string]any{
+		{"delay": map[string]any{"duration": "1h"}},
+		{"wait_event": map[string]any{"event": "confirmed"}},
+	}, config.Stages)
+
+	model.Stages = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`["delay"]`),
+		types.StringValue(`null`),
+	})
+	_, diags = model.CreateConfig(context.Background())
+	require.Equal(t, 2, diags.ErrorsCount())
+}
+
+func TestOrchestrationWorkflowFromWorkflow(t *testing.T) {
+	t.Parallel()
+
+	model := OrchestrationWorkflowModel{
+		Stages: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue(`{ "send": { "amount": { "amount": 100, "asset": "USD/2" } } }`),
+			types.StringValue(`{"delay":{"duration":"1h"}}`),
+		}),
+	}
+	diags := model.fromWorkflow(context.Background(), shared.V2Workflow{
+		ID:        "workflow",
+		CreatedAt: time.Date(2025, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+		Config: shared.V2WorkflowConfig{
+			Name: pointer.For("payout"),
+			Stages: []map[string]any{
+				{"send": map[string]any{"amount": map[string]any{"asset": "USD/2", "amount": int64(100)}}},
+				{"delay": map[string]any{"duration": "2h"}},
+			},
+		},
+	})
+	require.False(t, diags.HasError())
+	require.Equal(t, "workflow", model.ID.ValueString())
+	require.Equal(t, "payout", model.Name.ValueString())
+	require.Equal(t, "2025-01-01T00:00:00Z", model.CreatedAt.ValueString())
+	require.True(t, model.Stages.Equal(types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`{ "send": { "amount": { "amount": 100, "asset": "USD/2" } } }`),
+		types.StringValue(`{"delay":{"duration":"2h"}}`),
+	})))
+}
@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"iter"
 	"maps"
+	"time"
 
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
 	"github.com/formancehq/go-libs/v3/logging"
 	"github.com/formancehq/terraform-provider-stack/internal"
 	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -41,9 +44,11 @@ func SanitizeUnknownKeys(m map[string]attr.Value, allowedKeys []string) map[stri
 }
 
 var (
-	_ resource.Resource                   = &PaymentsConnectors{}
-	_ resource.ResourceWithConfigure      = &PaymentsConnectors{}
-	_ resource.ResourceWithValidateConfig = &PaymentsConnectors{}
+	_ resource.Resource                     = &PaymentsConnectors{}
+	_ resource.ResourceWithConfigure        = &PaymentsConnectors{}
+	_ resource.ResourceWithValidateConfig   = &PaymentsConnectors{}
+	_ resource.ResourceWithConfigValidators = &PaymentsConnectors{}
+	_ resource.ResourceWithModifyPlan       = &PaymentsConnectors{}
 )
 
 type PaymentsConnectors struct {
@@ -51,9 +56,35 @@ type PaymentsConnectors struct {
 }
 
 type PaymentsConnectorsModel struct {
-	ID          types.String  `tfsdk:"id"`
-	Credentials types.Dynamic `tfsdk:"credentials"`
-	Config      types.Dynamic `tfsdk:"config"`
+	ID                 types.String  `tfsdk:"id"`
+	Credentials        types.Dynamic `tfsdk:"credentials"`
+	CredentialsWO      types.Dynamic `tfsdk:"credentials_wo"`
+	CredentialsVersion types.Int64   `tfsdk:"credentials_version"`
+	Config             types.Dynamic `tfsdk:"config"`
+	ResetTrigger       types.String  `tfsdk:"reset_trigger"`
+	LastResetAt        types.String  `tfsdk:"last_reset_at"`
+}
+
+// resetRequested reports whether the plan asks for a connector reset compared to the prior state.
+// Only a change of a previous trigger resets the connector, setting the first trigger of a managed connector does not.
+func (m PaymentsConnectorsModel) resetRequested(state PaymentsConnectorsModel) bool {
+	return !state.ResetTrigger.IsNull() && !m.ResetTrigger.IsNull() && !m.ResetTrigger.Equal(state.ResetTrigger)
+}
+
+// configChanged reports whether the connector configuration must be sent again to the API.
+func (m PaymentsConnectorsModel) configChanged(state PaymentsConnectorsModel) bool {
+	return !m.Config.Equal(state.Config) ||
+		!m.Credentials.Equal(state.Credentials) ||
+		!m.CredentialsVersion.Equal(state.CredentialsVersion)
+}
+
+// withConfigCredentials returns a copy of the model carrying the write-only credentials from the configuration.
+// The returned model must only be used to build API requests and never be saved in the state.
+func (m PaymentsConnectorsModel) withConfigCredentials(conf PaymentsConnectorsModel) PaymentsConnectorsModel {
+	if !conf.CredentialsWO.IsNull() {
+		m.Credentials = conf.CredentialsWO
+	}
+	return m
 }
 
 func (m PaymentsConnectorsModel) installConfig(ctx context.Context) (operations.V3InstallConnectorRequest, error) {
@@ -107,14 +138,16 @@ func (m PaymentsConnectorsModel) StateFromRequest(resp *shared.V3InstallConnecto
 
 	tfValues := ConvertToAttrValues(values)
 
-	allowedCredsKeys := ExtractKeys(m.Credentials.UnderlyingValue().(types.Object).Attributes())
 	allowedConfigKeys := ExtractKeys(m.Config.UnderlyingValue().(types.Object).Attributes())
-
-	creds := SanitizeUnknownKeys(tfValues, allowedCredsKeys)
 	config := SanitizeUnknownKeys(tfValues, allowedConfigKeys)
 
 	plan.Config = types.DynamicValue(types.ObjectValueMust(GetMapTypeFromAttrTypes(config), config))
-	plan.Credentials = types.DynamicValue(types.ObjectValueMust(GetMapTypeFromAttrTypes(creds), creds))
+	// Secrets are masked or omitted by the API, credentials are always kept from the prior state.
+	plan.Credentials = m.Credentials
+	plan.CredentialsWO = types.DynamicNull()
+	plan.CredentialsVersion = m.CredentialsVersion
+	plan.ResetTrigger = m.ResetTrigger
+	plan.LastResetAt = m.LastResetAt
 	plan.ID = m.ID
 
 	return plan, nil
@@ -127,7 +160,7 @@ func NewPaymentsConnectors() func() resource.Resource {
 }
 
 var SchemaPaymentsConnectors = schema.Schema{
-	Description: "Resource for managing Formance Payments Connectors. For advanced usage and configuration, see the [Payments Connectors documentation](https://docs.formance.com/payments/connectors/).",
+	Description: "Resource for managing Formance Payments Connectors. For advanced usage and configuration, see the [Payments Connectors documentation](https://docs.formance.com/payments/connectors/). The install API only returns the ID of the connector and no task, so creation returns as soon as the connector is registered, without waiting for it to be installed, and install failures are not reported. Uninstallation and resets wait for their task, within `wait_task_duration`.",
 	Attributes: map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed:    true,
@@ -135,13 +168,31 @@ var SchemaPaymentsConnectors = schema.Schema{
 		},
 		"credentials": schema.DynamicAttribute{
 			Sensitive:   true,
-			Description: "The credentials for the payment connector. This should include sensitive information like API keys, secrets, certificate, and must be handled securely. Advanced usage: See [Payments Connectors documentation](https://docs.formance.com/payments/connectors/) for connector security best practices.",
-			Required:    true,
+			Description: "The credentials for the payment connector. This should include sensitive information like API keys, secrets, certificate, and must be handled securely. The value is stored in the state, prefer `credentials_wo` with Terraform 1.11 and later. Conflicts with `credentials_wo`. Advanced usage: See [Payments Connectors documentation](https://docs.formance.com/payments/connectors/) for connector security best practices.",
+			Optional:    true,
+		},
+		"credentials_wo": schema.DynamicAttribute{
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: "The write-only credentials for the payment connector. The value is sent to the API but never stored in the plan or the state. Bump `credentials_version` to push new credentials. Conflicts with `credentials`.",
+			Optional:    true,
+		},
+		"credentials_version": schema.Int64Attribute{
+			Optional:    true,
+			Description: "An arbitrary version of the credentials. Changing it updates the connector with the current credentials, which is required to rotate `credentials_wo`.",
 		},
 		"config": schema.DynamicAttribute{
 			Required:    true,
 			Description: "The configuration for the payment connector. It must not contain sensitive information like API keys or secrets. Advanced usage: See [Payments Connectors documentation](https://docs.formance.com/payments/connectors/) for connector configuration options.",
 		},
+		"reset_trigger": schema.StringAttribute{
+			Optional:    true,
+			Description: "An arbitrary value which, when changed from a previous value, resets the connector: its data is wiped and synchronized again from the provider. Setting it on creation or for the first time on an existing connector does not reset the connector.",
+		},
+		"last_reset_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date of the last reset triggered through `reset_trigger`, in RFC 3339 format.",
+		},
 	},
 }
 
@@ -150,6 +201,46 @@ func (s *PaymentsConnectors) Schema(ctx context.Context, req resource.SchemaRequ
 	res.Schema = SchemaPaymentsConnectors
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+func (s *PaymentsConnectors) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("credentials"),
+			path.MatchRoot("credentials_wo"),
+		),
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (s *PaymentsConnectors) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PaymentsConnectorsModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("last_reset_at"), types.StringNull())...)
+		return
+	}
+
+	var state PaymentsConnectorsModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	lastResetAt := state.LastResetAt
+	if plan.resetRequested(state) {
+		lastResetAt = types.StringUnknown()
+	}
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("last_reset_at"), lastResetAt)...)
+}
+
 // Configure implements resource.ResourceWithConfigure.
 func (s *PaymentsConnectors) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -177,13 +268,7 @@ func (s *PaymentsConnectors) ValidateConfig(ctx context.Context, req resource.Va
 		return
 	}
 
-	if conf.Credentials.IsNull() {
-		res.Diagnostics.AddAttributeError(
-			path.Root("credentials"),
-			"Missing Credentials",
-			"The credentials attribute is required and cannot be null.",
-		)
-	} else {
+	if !conf.Credentials.IsNull() && !conf.Credentials.IsUnknown() {
 		if _, ok := conf.Credentials.UnderlyingValue().(types.Object); !ok {
 			res.Diagnostics.AddAttributeError(
 				path.Root("credentials"),
@@ -193,6 +278,16 @@ func (s *PaymentsConnectors) ValidateConfig(ctx context.Context, req resource.Va
 		}
 	}
 
+	if !conf.CredentialsWO.IsNull() && !conf.CredentialsWO.IsUnknown() {
+		if _, ok := conf.CredentialsWO.UnderlyingValue().(types.Object); !ok {
+			res.Diagnostics.AddAttributeError(
+				path.Root("credentials_wo"),
+				"Invalid Credentials Type",
+				"The credentials_wo attribute must be an Object type.",
+			)
+		}
+	}
+
 	if conf.Config.IsNull() {
 		res.Diagnostics.AddAttributeError(
 			path.Root("config"),
@@ -214,12 +309,14 @@ func (s *PaymentsConnectors) ValidateConfig(ctx context.Context, req resource.Va
 // Create implements resource.Resource.
 func (s *PaymentsConnectors) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
 	var plan PaymentsConnectorsModel
+	var conf PaymentsConnectorsModel
 	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
 	if res.Diagnostics.HasError() {
 		return
 	}
 
-	config, err := plan.installConfig(ctx)
+	config, err := plan.withConfigCredentials(conf).installConfig(ctx)
 	if err != nil {
 		res.Diagnostics.AddError(
 			"Invalid Connector Configuration",
@@ -241,7 +338,10 @@ func (s *PaymentsConnectors) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	plan.ID = types.StringValue(resp.V3InstallConnectorResponse.Data)
+	plan.LastResetAt = types.StringNull()
 
+	// The install API returns no task to wait for, the connector is installed asynchronously by the module
+	// and install failures cannot be reported, as stated in the resource description.
 	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
 }
 
@@ -259,13 +359,19 @@ func (s *PaymentsConnectors) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	sdkPaymentsConnectors := s.store.Payments()
-	_, err := sdkPaymentsConnectors.DeleteConnector(ctx, operations.V3UninstallConnectorRequest{
+	resp, err := sdkPaymentsConnectors.DeleteConnector(ctx, operations.V3UninstallConnectorRequest{
 		ConnectorID: state.ID.ValueString(),
 	})
 	if err != nil {
 		sdk.HandleStackError(ctx, err, &res.Diagnostics)
 		return
 	}
+
+	// Nothing is awaited when the API does not return the uninstall task.
+	if resp.V3UninstallConnectorResponse == nil || resp.V3UninstallConnectorResponse.Data.TaskID == "" {
+		return
+	}
+	s.store.WaitPaymentsTask(ctx, resp.V3UninstallConnectorResponse.Data.TaskID, &res.Diagnostics)
 }
 
 // Metadata implements resource.Resource.
@@ -310,8 +416,10 @@ func (s *PaymentsConnectors) Read(ctx context.Context, req resource.ReadRequest,
 func (s *PaymentsConnectors) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
 	var plan PaymentsConnectorsModel
 	var state PaymentsConnectorsModel
+	var conf PaymentsConnectorsModel
 	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
 	if res.Diagnostics.HasError() {
 		return
 	}
@@ -322,23 +430,69 @@ func (s *PaymentsConnectors) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	sdkPayments := s.store.Payments()
-	config, err := plan.installConfig(ctx)
+	plan.ID = state.ID
+	plan.LastResetAt = state.LastResetAt
+
+	if plan.configChanged(state) {
+		config, err := plan.withConfigCredentials(conf).installConfig(ctx)
+		if err != nil {
+			res.Diagnostics.AddError(
+				"Invalid Connector Configuration",
+				fmt.Sprintf("Failed to create connector configuration: %v", err),
+			)
+			return
+		}
+
+		_, err = sdkPayments.UpdateConnector(ctx, operations.V3UpdateConnectorConfigRequest{
+			ConnectorID:               state.ID.ValueString(),
+			V3InstallConnectorRequest: config.V3InstallConnectorRequest,
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+	}
+
+	if plan.resetRequested(state) {
+		s.resetConnector(ctx, &plan, &res.Diagnostics)
+		if res.Diagnostics.HasError() {
+			// The applied config is saved, the previous trigger runs the reset again on the next apply.
+			plan.ResetTrigger = state.ResetTrigger
+			plan.LastResetAt = state.LastResetAt
+			res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// resetConnector resets the connector, waits for the reset task and records its completion date in the model.
+func (s *PaymentsConnectors) resetConnector(ctx context.Context, plan *PaymentsConnectorsModel, diagnostics *diag.Diagnostics) {
+	resp, err := s.store.Payments().ResetConnector(ctx, operations.V3ResetConnectorRequest{
+		ConnectorID: plan.ID.ValueString(),
+	})
 	if err != nil {
-		res.Diagnostics.AddError(
-			"Invalid Connector Configuration",
-			fmt.Sprintf("Failed to create connector configuration: %v", err),
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+
+	if resp.V3ResetConnectorResponse == nil || resp.V3ResetConnectorResponse.Data == "" {
+		diagnostics.AddError(
+			"Missing Reset Task",
+			fmt.Sprintf("The API did not return the task resetting the connector %s.", plan.ID.ValueString()),
 		)
 		return
 	}
 
-	_, err = sdkPayments.UpdateConnector(ctx, operations.V3UpdateConnectorConfigRequest{
-		ConnectorID:               state.ID.ValueString(),
-		V3InstallConnectorRequest: config.V3InstallConnectorRequest,
-	})
-	if err != nil {
-		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+	task := s.store.WaitPaymentsTask(ctx, resp.V3ResetConnectorResponse.Data, diagnostics)
+	if diagnostics.HasError() {
 		return
 	}
-	plan.ID = state.ID
-	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+
+	resetAt := task.UpdatedAt
+	if resetAt.IsZero() {
+		resetAt = time.Now()
+	}
+	plan.LastResetAt = types.StringValue(resetAt.UTC().Format(time.RFC3339))
 }
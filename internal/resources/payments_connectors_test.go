@@ -188,14 +188,14 @@ func TestPaymentsStateFromRequest(t *testing.T) {
 			request: &shared.V3GetConnectorConfigResponse{
 				Data: shared.V3InstallConnectorRequest{
 					V3AdyenConfig: &shared.V3AdyenConfig{
-						APIKey:             "api-key-value",
+						APIKey:             "********",
 						Name:               "Example Connector",
 						PageSize:           pointer.For(int64(50)),
 						PollingPeriod:      pointer.For("2m"),
 						Provider:           pointer.For("Adyen"),
 						CompanyID:          "company-id-value",
 						LiveEndpointPrefix: pointer.For("https://live.example.com"),
-						WebhookPassword:    pointer.For("webhook-password"),
+						WebhookPassword:    pointer.For("********"),
 						WebhookUsername:    pointer.For("webhook-username"),
 					},
 				},
@@ -217,8 +217,29 @@ func TestPaymentsStateFromRequest(t *testing.T) {
 				}).Value()),
 			},
 		},
+		{
+			request: &shared.V3GetConnectorConfigResponse{
+				Data: shared.V3InstallConnectorRequest{
+					V3AdyenConfig: &shared.V3AdyenConfig{
+						APIKey:   "********",
+						Name:     "Write Only Connector",
+						Provider: pointer.For("Adyen"),
+					},
+				},
+			},
+			fromState: PaymentsConnectorsModel{
+				ID:                 types.StringValue("somevalue"),
+				Credentials:        types.DynamicNull(),
+				CredentialsWO:      types.DynamicNull(),
+				CredentialsVersion: types.Int64Value(2),
+				Config: types.DynamicValue(NewDynamicObjectValue(map[string]attr.Value{
+					"name":     types.StringValue("Write Only Connector"),
+					"provider": types.StringValue("Adyen"),
+				}).Value()),
+			},
+		},
 	} {
-		t.Run(fmt.Sprintf("%s %s", t.Name(), *tc.request.Data.V3AdyenConfig.Provider), func(t *testing.T) {
+		t.Run(fmt.Sprintf("%s %s", t.Name(), tc.request.Data.V3AdyenConfig.Name), func(t *testing.T) {
 			state, err := tc.fromState.StateFromRequest(&tc.request.Data)
 			if err != nil {
 				t.Fatalf("failed to create state from request: %v", err)
@@ -229,3 +250,59 @@ func TestPaymentsStateFromRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestPaymentsConnectorsResetRequested(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name     string
+		plan     types.String
+		state    types.String
+		expected bool
+	}
+
+	for _, tc := range []testCase{
+		{name: "unset", plan: types.StringNull(), state: types.StringNull(), expected: false},
+		{name: "unchanged", plan: types.StringValue("1"), state: types.StringValue("1"), expected: false},
+		{name: "set", plan: types.StringValue("1"), state: types.StringNull(), expected: false},
+		{name: "changed", plan: types.StringValue("2"), state: types.StringValue("1"), expected: true},
+		{name: "removed", plan: types.StringNull(), state: types.StringValue("1"), expected: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := PaymentsConnectorsModel{ResetTrigger: tc.plan}
+			state := PaymentsConnectorsModel{ResetTrigger: tc.state}
+			require.Equal(t, tc.expected, plan.resetRequested(state))
+		})
+	}
+}
+
+func TestPaymentsConnectorsConfigChanged(t *testing.T) {
+	t.Parallel()
+
+	state := PaymentsConnectorsModel{
+		Credentials: types.DynamicValue(NewDynamicObjectValue(map[string]attr.Value{
+			"apiKey": types.StringValue("api-key-value"),
+		}).Value()),
+		CredentialsVersion: types.Int64Value(1),
+		Config: types.DynamicValue(NewDynamicObjectValue(map[string]attr.Value{
+			"name": types.StringValue("Example Connector"),
+		}).Value()),
+		ResetTrigger: types.StringValue("1"),
+	}
+
+	plan := state
+	plan.ResetTrigger = types.StringValue("2")
+	require.False(t, plan.configChanged(state))
+
+	plan = state
+	plan.CredentialsVersion = types.Int64Value(2)
+	require.True(t, plan.configChanged(state))
+
+	plan = state
+	plan.Config = types.DynamicValue(NewDynamicObjectValue(map[string]attr.Value{
+		"name": types.StringValue("New Example Connector"),
+	}).Value())
+	require.True(t, plan.configChanged(state))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
@@ -13,11 +14,13 @@ import (
 	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-testing/compare"
 )
 
 var (
@@ -25,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure        = &PaymentsPool{}
 	_ resource.ResourceWithValidateConfig   = &PaymentsPool{}
 	_ resource.ResourceWithConfigValidators = &PaymentsPool{}
+	_ resource.ResourceWithModifyPlan       = &PaymentsPool{}
 )
 
 type PaymentsPool struct {
@@ -34,10 +38,16 @@ type PaymentsPool struct {
 type PaymentsPoolModel struct {
 	ID          types.String  `tfsdk:"id"`
 	Name        types.String  `tfsdk:"name"`
-	AccountsIds types.List    `tfsdk:"accounts_ids"`
+	AccountsIds types.Set     `tfsdk:"accounts_ids"`
+	Accounts    types.Set     `tfsdk:"accounts"`
 	Query       types.Dynamic `tfsdk:"query"`
 }
 
+type PaymentsPoolAccountModel struct {
+	ConnectorID types.String `tfsdk:"connector_id"`
+	Reference   types.String `tfsdk:"reference"`
+}
+
 func NewPaymentsPool() func() resource.Resource {
 	return func() resource.Resource {
 		return &PaymentsPool{}
@@ -52,16 +62,36 @@ var SchemaPaymentsPool = schema.Schema{
 			Description: "The unique identifier of the payments pool.",
 		},
 		"name": schema.StringAttribute{
-			Description: "The name of the pool.",
+			Description: "The name of the pool. Changing it replaces the pool.",
 			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
 		},
-		"accounts_ids": schema.ListAttribute{
-			Description: "The list of accounts IDs associated with the pool. For more information, see the [Payments documentation](https://docs.formance.com/payments/).",
+		"accounts_ids": schema.SetAttribute{
+			Description: "The set of accounts IDs associated with the pool. Computed from `accounts` when it is used instead. For more information, see the [Payments documentation](https://docs.formance.com/payments/).",
 			ElementType: types.StringType,
 			Optional:    true,
+			Computed:    true,
+		},
+		"accounts": schema.SetNestedAttribute{
+			Description: "The set of accounts associated with the pool, identified by their connector and their reference at the provider. The accounts are resolved to their IDs when planning, or when applying if the connector is not known yet.",
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"connector_id": schema.StringAttribute{
+						Description: "The unique identifier of the connector of the account.",
+						Required:    true,
+					},
+					"reference": schema.StringAttribute{
+						Description: "The reference of the account at the provider.",
+						Required:    true,
+					},
+				},
+			},
 		},
 		"query": schema.DynamicAttribute{
-			Description: "The query to filter payments associated with the pool. For more information, see the [Payments documentation](https://docs.formance.com/payments/).",
+			Description: "The query to filter payments associated with the pool. Switching between a static pool and a query pool replaces the pool. For more information, see the [Payments documentation](https://docs.formance.com/payments/).",
 			Optional:    true,
 		},
 	},
@@ -72,10 +102,12 @@ func (s *PaymentsPool) ConfigValidators(context.Context) []resource.ConfigValida
 	return []resource.ConfigValidator{
 		resourcevalidator.Conflicting(
 			path.MatchRoot("accounts_ids"),
+			path.MatchRoot("accounts"),
 			path.MatchRoot("query"),
 		),
 		resourcevalidator.AtLeastOneOf(
 			path.MatchRoot("accounts_ids"),
+			path.MatchRoot("accounts"),
 			path.MatchRoot("query"),
 		),
 	}
@@ -105,6 +137,46 @@ func (m *PaymentsPoolModel) ParseQuery() (map[string]any, error) {
 	return query, nil
 }
 
+// IsQueryPool reports whether the pool selects its accounts with a query rather than a static list.
+func (m *PaymentsPoolModel) IsQueryPool() bool {
+	return !m.Query.IsNull()
+}
+
+// QueryEquals compares the query of the model with the given one by their JSON semantics,
+// so that key order or number representation differences are not reported as changes.
+func (m *PaymentsPoolModel) QueryEquals(other map[string]any) (bool, error) {
+	query, err := m.ParseQuery()
+	if err != nil {
+		return false, err
+	}
+
+	left, err := normalizeJSON(query)
+	if err != nil {
+		return false, err
+	}
+	right, err := normalizeJSON(other)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(left, right), nil
+}
+
+// normalizeJSON round-trips the value through JSON so that its numbers are compared as float64.
+func normalizeJSON(v map[string]any) (any, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	var normalized any
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal value: %w", err)
+	}
+	return normalized, nil
+}
+
 // ValidateConfig implements resource.ResourceWithValidateConfig.
 func (s *PaymentsPool) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
 	var conf PaymentsPoolModel
@@ -126,6 +198,192 @@ func (s *PaymentsPool) ValidateConfig(ctx context.Context, req resource.Validate
 
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (s *PaymentsPool) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan PaymentsPoolModel
+	var conf PaymentsPoolModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	stateAccountsIds := types.SetNull(types.StringType)
+	if !req.State.Raw.IsNull() {
+		var state PaymentsPoolModel
+		res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+
+		// A pool cannot be converted between static and query based, the switch is done by replacing it.
+		if state.IsQueryPool() != plan.IsQueryPool() {
+			res.RequiresReplace = append(res.RequiresReplace, path.Root("query"))
+		}
+		stateAccountsIds = state.AccountsIds
+	}
+
+	if !conf.AccountsIds.IsNull() {
+		if s.store != nil && !plan.AccountsIds.IsUnknown() {
+			s.checkAccounts(ctx, plan.AccountsIds, stateAccountsIds, &res.Diagnostics)
+		}
+		return
+	}
+
+	if plan.Accounts.IsNull() {
+		// Pools defined by a query have no static accounts, keep whatever the API reported.
+		accountsIds := types.SetNull(types.StringType)
+		if !req.State.Raw.IsNull() {
+			res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("accounts_ids"), &accountsIds)...)
+		}
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("accounts_ids"), accountsIds)...)
+		return
+	}
+
+	if s.store == nil || plan.Accounts.IsUnknown() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	accountsIds := s.resolveAccounts(ctx, plan.Accounts, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("accounts_ids"), accountsIds)...)
+}
+
+// checkAccounts verifies that the known account IDs added to the pool exist.
+// The accounts already in the state were checked by a previous plan and are skipped.
+func (s *PaymentsPool) checkAccounts(ctx context.Context, accountsIds types.Set, stateAccountsIds types.Set, diagnostics *diag.Diagnostics) {
+	checked := map[string]struct{}{}
+	for _, id := range stateAccountsIds.Elements() {
+		if id, ok := id.(types.String); ok {
+			checked[id.ValueString()] = struct{}{}
+		}
+	}
+
+	ids := []string{}
+	for _, id := range accountsIds.Elements() {
+		id, ok := id.(types.String)
+		if !ok || id.IsUnknown() {
+			continue
+		}
+		if _, ok := checked[id.ValueString()]; !ok {
+			ids = append(ids, id.ValueString())
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	sdkPayments := s.store.Payments()
+	for _, id := range ids {
+		_, err := sdkPayments.GetAccount(ctx, operations.V3GetAccountRequest{
+			AccountID: id,
+		})
+		if err == nil {
+			continue
+		}
+		if !sdk.IsNotFoundError(err) {
+			sdk.HandleStackError(ctx, err, diagnostics)
+			return
+		}
+		diagnostics.AddAttributeError(
+			path.Root("accounts_ids").AtSetValue(types.StringValue(id)),
+			"Account Not Found",
+			fmt.Sprintf("No account with ID '%s' was found.", id),
+		)
+	}
+}
+
+// resolveAccounts resolves the (connector_id, reference) pairs of the accounts attribute to account IDs.
+// An unknown set is returned when a pair is not known yet, the resolution is then deferred to the apply.
+func (s *PaymentsPool) resolveAccounts(ctx context.Context, accounts types.Set, diagnostics *diag.Diagnostics) types.Set {
+	var models []PaymentsPoolAccountModel
+	diagnostics.Append(accounts.ElementsAs(ctx, &models, false)...)
+	if diagnostics.HasError() {
+		return types.SetUnknown(types.StringType)
+	}
+
+	for _, account := range models {
+		if account.ConnectorID.IsUnknown() || account.Reference.IsUnknown() {
+			return types.SetUnknown(types.StringType)
+		}
+	}
+
+	sdkPayments := s.store.Payments()
+	ids := []attr.Value{}
+	for _, account := range models {
+		connectorID := account.ConnectorID.ValueString()
+		reference := account.Reference.ValueString()
+		found, err := sdk.Paginate(ctx, func(ctx context.Context, cursor *string) (sdk.Cursor[shared.V3Account], error) {
+			request := operations.V3ListAccountsRequest{
+				Cursor: cursor,
+			}
+			if cursor == nil {
+				request.Query = map[string]any{
+					"$and": []any{
+						map[string]any{"$match": map[string]any{"connector_id": connectorID}},
+						map[string]any{"$match": map[string]any{"reference": reference}},
+					},
+				}
+			}
+			resp, err := sdkPayments.ListAccounts(ctx, request)
+			if err != nil {
+				return sdk.Cursor[shared.V3Account]{}, err
+			}
+			cursorResp := resp.V3AccountsCursorResponse.Cursor
+			return sdk.Cursor[shared.V3Account]{
+				Data:    cursorResp.Data,
+				HasMore: cursorResp.HasMore,
+				Next:    cursorResp.Next,
+			}, nil
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, diagnostics)
+			return types.SetUnknown(types.StringType)
+		}
+
+		found = collectionutils.Filter(found, func(a shared.V3Account) bool {
+			return a.ConnectorID == connectorID && a.Reference == reference
+		})
+		switch len(found) {
+		case 0:
+			diagnostics.AddAttributeError(
+				path.Root("accounts"),
+				"Account Not Found",
+				fmt.Sprintf("No account with reference '%s' was found for the connector '%s'.", reference, connectorID),
+			)
+		case 1:
+			ids = append(ids, types.StringValue(found[0].ID))
+		default:
+			diagnostics.AddAttributeError(
+				path.Root("accounts"),
+				"Ambiguous Account",
+				fmt.Sprintf("%d accounts with reference '%s' were found for the connector '%s'.", len(found), reference, connectorID),
+			)
+		}
+	}
+	if diagnostics.HasError() {
+		return types.SetUnknown(types.StringType)
+	}
+
+	return types.SetValueMust(types.StringType, ids)
+}
+
 // Configure implements resource.ResourceWithConfigure.
 func (s *PaymentsPool) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -164,6 +422,13 @@ func (s *PaymentsPool) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	if plan.AccountsIds.IsUnknown() {
+		plan.AccountsIds = s.resolveAccounts(ctx, plan.Accounts, &res.Diagnostics)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	sdkPayments := s.store.Payments()
 	resp, err := sdkPayments.CreatePool(ctx, &shared.V3CreatePoolRequest{
 		Name: plan.Name.ValueString(),
@@ -235,7 +500,7 @@ func (s *PaymentsPool) Read(ctx context.Context, req resource.ReadRequest, res *
 	state.ID = types.StringValue(resp.V3GetPoolResponse.Data.ID)
 	state.Name = types.StringValue(resp.V3GetPoolResponse.Data.Name)
 	if len(resp.V3GetPoolResponse.Data.PoolAccounts) > 0 {
-		state.AccountsIds = types.ListValueMust(
+		state.AccountsIds = types.SetValueMust(
 			types.StringType,
 			collectionutils.Map(resp.V3GetPoolResponse.Data.PoolAccounts, func(account string) attr.Value {
 				return types.StringValue(account)
@@ -245,8 +510,11 @@ func (s *PaymentsPool) Read(ctx context.Context, req resource.ReadRequest, res *
 
 	query := resp.V3GetPoolResponse.Data.Query
 	if len(query) > 0 {
-		tfValues := ConvertToAttrValues(query)
-		state.Query = types.DynamicValue(NewDynamicObjectValue(tfValues).Value())
+		equals, err := state.QueryEquals(query)
+		if err != nil || !equals {
+			tfValues := ConvertToAttrValues(query)
+			state.Query = types.DynamicValue(NewDynamicObjectValue(tfValues).Value())
+		}
 	}
 
 	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
@@ -285,6 +553,28 @@ func (s *PaymentsPool) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if plan.IsQueryPool() {
+		s.updateQuery(ctx, plan, state, &res.Diagnostics)
+	} else {
+		if plan.AccountsIds.IsUnknown() {
+			plan.AccountsIds = s.resolveAccounts(ctx, plan.Accounts, &res.Diagnostics)
+			if res.Diagnostics.HasError() {
+				return
+			}
+		}
+		s.updateAccounts(ctx, plan, state, &res.Diagnostics)
+	}
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// updateAccounts adds and removes the accounts of a static pool to match the plan.
+func (s *PaymentsPool) updateAccounts(ctx context.Context, plan, state PaymentsPoolModel, diagnostics *diag.Diagnostics) {
 	planAccountIds := collectionutils.Map(plan.AccountsIds.Elements(), func(account attr.Value) string {
 		return account.(types.String).ValueString()
 	})
@@ -292,55 +582,53 @@ func (s *PaymentsPool) Update(ctx context.Context, req resource.UpdateRequest, r
 		return account.(types.String).ValueString()
 	})
 
-	accountToAdd := diff(planAccountIds, stateAccountIds)
-	accountToRemove := diff(stateAccountIds, planAccountIds)
-
-	for _, accountID := range accountToAdd {
+	for _, accountID := range diff(planAccountIds, stateAccountIds) {
 		_, err := s.store.Payments().AddAccountToPool(ctx, operations.V3AddAccountToPoolRequest{
 			PoolID:    state.ID.ValueString(),
 			AccountID: accountID,
 		})
 		if err != nil {
-			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			sdk.HandleStackError(ctx, err, diagnostics)
 			return
 		}
 	}
 
-	for _, accountID := range accountToRemove {
+	for _, accountID := range diff(stateAccountIds, planAccountIds) {
 		_, err := s.store.Payments().RemoveAccountFromPool(ctx, operations.V3RemoveAccountFromPoolRequest{
 			PoolID:    state.ID.ValueString(),
 			AccountID: accountID,
 		})
 		if err != nil {
-			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			sdk.HandleStackError(ctx, err, diagnostics)
 			return
 		}
 	}
+}
 
+// updateQuery sends the query of a query pool when it differs semantically from the state.
+func (s *PaymentsPool) updateQuery(ctx context.Context, plan, state PaymentsPoolModel, diagnostics *diag.Diagnostics) {
 	planQuery, err := plan.ParseQuery()
 	if err != nil {
-		res.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Failed to read payments pool policy query: %s", err))
+		diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Failed to read payments pool policy query: %s", err))
 		return
 	}
-	_, err = state.ParseQuery()
+	equals, err := state.QueryEquals(planQuery)
 	if err != nil {
-		res.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Failed to read payments pool policy query: %s", err))
+		diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Failed to read payments pool policy query: %s", err))
 		return
 	}
-	if err := compare.ValuesDiffer().CompareValues(plan.Query, state.Query); err == nil {
-		_, err := s.store.Payments().UpdatePool(ctx, operations.V3UpdatePoolQueryRequest{
-			V3UpdatePoolQueryRequest: &shared.V3UpdatePoolQueryRequest{
-				Query: planQuery,
-			},
-			PoolID: state.ID.String(),
-		})
-		if err != nil {
-			sdk.HandleStackError(ctx, err, &res.Diagnostics)
-			return
-		}
+	if equals {
+		return
 	}
 
-	plan.ID = state.ID
-
-	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+	_, err = s.store.Payments().UpdatePool(ctx, operations.V3UpdatePoolQueryRequest{
+		V3UpdatePoolQueryRequest: &shared.V3UpdatePoolQueryRequest{
+			Query: planQuery,
+		},
+		PoolID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
 }
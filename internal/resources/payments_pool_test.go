@@ -0,0 +1,172 @@
+package resources
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/sdkerrors"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+var poolAccountType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"connector_id": types.StringType,
+	"reference":    types.StringType,
+}}
+
+func newPoolAccount(connectorID, reference types.String) attr.Value {
+	return types.ObjectValueMust(poolAccountType.AttrTypes, map[string]attr.Value{
+		"connector_id": connectorID,
+		"reference":    reference,
+	})
+}
+
+func TestPaymentsPoolResolveAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	stackSdk := sdk.NewMockStackSdkImpl(ctrl)
+	paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+	stackSdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+	pool := &PaymentsPool{store: &internal.ModuleStore{StackSdkImpl: stackSdk}}
+
+	paymentsSdk.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).Return(&operations.V3ListAccountsResponse{
+		V3AccountsCursorResponse: &shared.V3AccountsCursorResponse{
+			Cursor: shared.V3AccountsCursorResponseCursor{
+				Data: []shared.V3Account{
+					{ID: "acc-1", ConnectorID: "connector", Reference: "ref-1"},
+					{ID: "acc-2", ConnectorID: "connector", Reference: "ref-2"},
+				},
+			},
+		},
+	}, nil).Times(3)
+
+	t.Run("resolved", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ids := pool.resolveAccounts(logging.TestingContext(), types.SetValueMust(poolAccountType, []attr.Value{
+			newPoolAccount(types.StringValue("connector"), types.StringValue("ref-2")),
+			newPoolAccount(types.StringValue("connector"), types.StringValue("ref-1")),
+		}), &diags)
+		require.False(t, diags.HasError(), "%v", diags)
+		require.True(t, ids.Equal(types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("acc-1"),
+			types.StringValue("acc-2"),
+		})))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ids := pool.resolveAccounts(logging.TestingContext(), types.SetValueMust(poolAccountType, []attr.Value{
+			newPoolAccount(types.StringValue("connector"), types.StringValue("ref-3")),
+		}), &diags)
+		require.True(t, diags.HasError())
+		require.Equal(t, "Account Not Found", diags[0].Summary())
+		require.True(t, ids.IsUnknown())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		var diags diag.Diagnostics
+		ids := pool.resolveAccounts(logging.TestingContext(), types.SetValueMust(poolAccountType, []attr.Value{
+			newPoolAccount(types.StringUnknown(), types.StringValue("ref-1")),
+		}), &diags)
+		require.False(t, diags.HasError())
+		require.True(t, ids.IsUnknown())
+	})
+}
+
+func TestPaymentsPoolCheckAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	stackSdk := sdk.NewMockStackSdkImpl(ctrl)
+	paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+	stackSdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+	stackSdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+		GetVersionsResponse: &shared.GetVersionsResponse{
+			Versions: []shared.Version{
+				{Name: "payments", Health: true},
+			},
+		},
+	}, nil).AnyTimes()
+
+	store := &internal.Store{StackSdkImpl: stackSdk, WaitModuleTimeout: time.Minute}
+	pool := &PaymentsPool{store: store.NewModuleStore("payments")}
+
+	// acc-1 is already in the state and is not checked again
+	paymentsSdk.EXPECT().GetAccount(gomock.Any(), operations.V3GetAccountRequest{AccountID: "acc-2"}).Return(&operations.V3GetAccountResponse{}, nil)
+	paymentsSdk.EXPECT().GetAccount(gomock.Any(), operations.V3GetAccountRequest{AccountID: "acc-3"}).
+		Return(nil, sdkerrors.NewSDKError("API error occurred", http.StatusNotFound, "", nil))
+
+	var diags diag.Diagnostics
+	pool.checkAccounts(logging.TestingContext(),
+		types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("acc-1"),
+			types.StringValue("acc-2"),
+			types.StringValue("acc-3"),
+		}),
+		types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("acc-1"),
+		}),
+		&diags,
+	)
+	require.Equal(t, 1, diags.ErrorsCount())
+	require.Equal(t, "Account Not Found", diags[0].Summary())
+	require.True(t, diags[0].(diag.DiagnosticWithPath).Path().Equal(path.Root("accounts_ids").AtSetValue(types.StringValue("acc-3"))))
+}
+
+func TestPaymentsPoolQueryEquals(t *testing.T) {
+	t.Parallel()
+
+	model := PaymentsPoolModel{
+		Query: types.DynamicValue(NewDynamicObjectValue(map[string]attr.Value{
+			"$and": NewDynamicTupleValue([]attr.Value{
+				NewDynamicObjectValue(map[string]attr.Value{
+					"$match": NewDynamicObjectValue(map[string]attr.Value{
+						"account": types.StringValue("accounts::pending"),
+					}).Value(),
+				}).Value(),
+				NewDynamicObjectValue(map[string]attr.Value{
+					"$gte": NewDynamicObjectValue(map[string]attr.Value{
+						"balance": types.Int64Value(1000),
+					}).Value(),
+				}).Value(),
+			}).Value(),
+		}).Value()),
+	}
+
+	equals, err := model.QueryEquals(map[string]any{
+		"$and": []any{
+			map[string]any{"$match": map[string]any{"account": "accounts::pending"}},
+			map[string]any{"$gte": map[string]any{"balance": float64(1000)}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, equals)
+
+	equals, err = model.QueryEquals(map[string]any{
+		"$and": []any{
+			map[string]any{"$match": map[string]any{"account": "accounts::another"}},
+			map[string]any{"$gte": map[string]any{"balance": int64(1000)}},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, equals)
+
+	empty := PaymentsPoolModel{Query: types.DynamicNull()}
+	equals, err = empty.QueryEquals(nil)
+	require.NoError(t, err)
+	require.True(t, equals)
+	require.False(t, empty.IsQueryPool())
+	require.True(t, model.IsQueryPool())
+}
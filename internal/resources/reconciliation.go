@@ -0,0 +1,278 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &Reconciliation{}
+	_ resource.ResourceWithConfigure      = &Reconciliation{}
+	_ resource.ResourceWithValidateConfig = &Reconciliation{}
+)
+
+type Reconciliation struct {
+	store *internal.ModuleStore
+}
+
+type ReconciliationModel struct {
+	ID                   types.String `tfsdk:"id"`
+	PolicyID             types.String `tfsdk:"policy_id"`
+	ReconciledAtLedger   types.String `tfsdk:"reconciled_at_ledger"`
+	ReconciledAtPayments types.String `tfsdk:"reconciled_at_payments"`
+	Status               types.String `tfsdk:"status"`
+	Error                types.String `tfsdk:"error"`
+	Drift                types.Map    `tfsdk:"drift"`
+	CreatedAt            types.String `tfsdk:"created_at"`
+}
+
+// CreateConfig parses the reconciliation dates of the model into a reconcile request.
+func (m *ReconciliationModel) CreateConfig() (operations.ReconcileRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	reconciledAtLedger, err := time.Parse(time.RFC3339, m.ReconciledAtLedger.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("reconciled_at_ledger"),
+			"Invalid Date",
+			fmt.Sprintf("The reconciled_at_ledger attribute must be a RFC 3339 date: %s", err),
+		)
+	}
+
+	reconciledAtPayments, err := time.Parse(time.RFC3339, m.ReconciledAtPayments.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("reconciled_at_payments"),
+			"Invalid Date",
+			fmt.Sprintf("The reconciled_at_payments attribute must be a RFC 3339 date: %s", err),
+		)
+	}
+
+	return operations.ReconcileRequest{
+		PolicyID: m.PolicyID.ValueString(),
+		ReconciliationRequest: shared.ReconciliationRequest{
+			ReconciledAtLedger:   reconciledAtLedger,
+			ReconciledAtPayments: reconciledAtPayments,
+		},
+	}, diags
+}
+
+// fromReconciliation fills the computed attributes of the model from the reconciliation returned by the API.
+// The reconciliation dates are kept as configured when they denote the same instant.
+func (m *ReconciliationModel) fromReconciliation(ctx context.Context, reconciliation shared.Reconciliation) diag.Diagnostics {
+	m.ID = types.StringValue(reconciliation.ID)
+	m.PolicyID = types.StringValue(reconciliation.PolicyID)
+	m.ReconciledAtLedger = keepEqualInstant(m.ReconciledAtLedger, reconciliation.ReconciledAtLedger)
+	m.ReconciledAtPayments = keepEqualInstant(m.ReconciledAtPayments, reconciliation.ReconciledAtPayments)
+	m.Status = types.StringValue(reconciliation.Status)
+	m.Error = types.StringPointerValue(reconciliation.Error)
+	m.CreatedAt = types.StringValue(reconciliation.CreatedAt.UTC().Format(time.RFC3339))
+
+	drift := make(map[string]*big.Float, len(reconciliation.DriftBalances))
+	for asset, amount := range reconciliation.DriftBalances {
+		value := new(big.Float)
+		if amount != nil {
+			value.SetInt(amount)
+		}
+		drift[asset] = value
+	}
+
+	var diags diag.Diagnostics
+	m.Drift, diags = types.MapValueFrom(ctx, types.NumberType, drift)
+	return diags
+}
+
+func keepEqualInstant(value types.String, instant time.Time) types.String {
+	if current, err := time.Parse(time.RFC3339, value.ValueString()); err == nil && current.Equal(instant) {
+		return value
+	}
+	return types.StringValue(instant.UTC().Format(time.RFC3339))
+}
+
+func NewReconciliation() func() resource.Resource {
+	return func() resource.Resource {
+		return &Reconciliation{}
+	}
+}
+
+var SchemaReconciliation = schema.Schema{
+	Description: "Resource running a Formance Reconciliation of a policy at a point in time. The run is immutable, any change of its attributes triggers a new run. For advanced usage and configuration, see the [Reconciliation documentation](https://docs.formance.com/reconciliation/).",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the reconciliation.",
+		},
+		"policy_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The ID of the reconciliation policy to run.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"reconciled_at_ledger": schema.StringAttribute{
+			Required:    true,
+			Description: "The point in time of the ledger balances, in RFC 3339 format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"reconciled_at_payments": schema.StringAttribute{
+			Required:    true,
+			Description: "The point in time of the payments pool balances, in RFC 3339 format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "The status of the reconciliation, `OK` when the ledger and payments balances match.",
+		},
+		"error": schema.StringAttribute{
+			Computed:    true,
+			Description: "The error reported by the reconciliation, if any.",
+		},
+		"drift": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.NumberType,
+			Description: "The drift between the ledger and payments balances, indexed by asset, in the minor unit of the asset.",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The creation date of the reconciliation, in RFC 3339 format.",
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *Reconciliation) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaReconciliation
+}
+
+// Metadata implements resource.Resource.
+func (s *Reconciliation) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reconciliation"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *Reconciliation) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("reconciliation")
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (s *Reconciliation) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var conf ReconciliationModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if conf.ReconciledAtLedger.IsUnknown() || conf.ReconciledAtPayments.IsUnknown() {
+		return
+	}
+
+	_, diags := conf.CreateConfig()
+	res.Diagnostics.Append(diags...)
+}
+
+// Create implements resource.Resource.
+func (s *Reconciliation) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan ReconciliationModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := plan.CreateConfig()
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Reconciliation().Reconcile(ctx, config)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(plan.fromReconciliation(ctx, resp.ReconciliationResponse.Data)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read implements resource.Resource.
+func (s *Reconciliation) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state ReconciliationModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Reconciliation().GetReconciliation(ctx, operations.GetReconciliationRequest{
+		ReconciliationID: state.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	res.Diagnostics.Append(state.fromReconciliation(ctx, resp.ReconciliationResponse.Data)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// Update implements resource.Resource.
+// Every configurable attribute requires a replacement, the state is kept as is.
+func (s *Reconciliation) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var state ReconciliationModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// Delete implements resource.Resource.
+// Reconciliations cannot be deleted from the stack, the run is only removed from the state.
+func (s *Reconciliation) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/formancehq/go-libs/v3/query"
 	"github.com/formancehq/terraform-provider-stack/internal"
 	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
@@ -23,10 +25,13 @@ var (
 	_ resource.Resource                   = &ReconciliationPolicy{}
 	_ resource.ResourceWithConfigure      = &ReconciliationPolicy{}
 	_ resource.ResourceWithValidateConfig = &ReconciliationPolicy{}
+	_ resource.ResourceWithModifyPlan     = &ReconciliationPolicy{}
 )
 
 type ReconciliationPolicy struct {
-	store *internal.ModuleStore
+	store         *internal.ModuleStore
+	ledgerStore   *internal.ModuleStore
+	paymentsStore *internal.ModuleStore
 }
 
 type ReconciliationPolicyModel struct {
@@ -135,7 +140,8 @@ func (s *ReconciliationPolicy) Configure(ctx context.Context, req resource.Confi
 	}
 
 	s.store = store.NewModuleStore("reconciliation")
-
+	s.ledgerStore = store.NewModuleStore("ledger")
+	s.paymentsStore = store.NewModuleStore("payments")
 }
 
 // ValidateConfig implements resource.ResourceWithValidateConfig.
@@ -158,6 +164,81 @@ func (s *ReconciliationPolicy) ValidateConfig(ctx context.Context, req resource.
 
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// The referenced ledger and payments pool are checked when they are known and differ from the state.
+func (s *ReconciliationPolicy) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || s.store == nil {
+		return
+	}
+
+	var plan ReconciliationPolicyModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	var state ReconciliationPolicyModel
+	if !req.State.Raw.IsNull() {
+		res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.LedgerName.IsUnknown() && !plan.LedgerName.Equal(state.LedgerName) {
+		s.checkLedger(ctx, plan.LedgerName.ValueString(), &res.Diagnostics)
+	}
+	if !plan.PaymentsPoolID.IsUnknown() && !plan.PaymentsPoolID.Equal(state.PaymentsPoolID) {
+		s.checkPaymentsPool(ctx, plan.PaymentsPoolID.ValueString(), &res.Diagnostics)
+	}
+}
+
+func (s *ReconciliationPolicy) checkLedger(ctx context.Context, ledgerName string, diagnostics *diag.Diagnostics) {
+	s.ledgerStore.CheckModuleHealth(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.ledgerStore.Ledger().GetLedger(ctx, operations.V2GetLedgerRequest{
+		Ledger: ledgerName,
+	})
+	if err == nil {
+		return
+	}
+	if !sdk.IsNotFoundError(err) {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+	diagnostics.AddAttributeError(
+		path.Root("ledger_name"),
+		"Ledger Not Found",
+		fmt.Sprintf("No ledger named '%s' was found.", ledgerName),
+	)
+}
+
+func (s *ReconciliationPolicy) checkPaymentsPool(ctx context.Context, poolID string, diagnostics *diag.Diagnostics) {
+	s.paymentsStore.CheckModuleHealth(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.paymentsStore.Payments().GetPool(ctx, operations.V3GetPoolRequest{
+		PoolID: poolID,
+	})
+	if err == nil {
+		return
+	}
+	if !sdk.IsNotFoundError(err) {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+	diagnostics.AddAttributeError(
+		path.Root("payments_pool_id"),
+		"Payments Pool Not Found",
+		fmt.Sprintf("No payments pool with ID '%s' was found.", poolID),
+	)
+}
+
 // Create implements resource.Resource.
 func (s *ReconciliationPolicy) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
 	var plan ReconciliationPolicyModel
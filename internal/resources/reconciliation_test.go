@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciliationCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := ReconciliationModel{
+		PolicyID:             types.StringValue("policy"),
+		ReconciledAtLedger:   types.StringValue("2025-01-01T00:00:00Z"),
+		ReconciledAtPayments: types.StringValue("2025-01-02T00:00:00+02:00"),
+	}
+	config, diags := model.CreateConfig()
+	require.False(t, diags.HasError())
+	require.Equal(t, "policy", config.PolicyID)
+	require.True(t, config.ReconciliationRequest.ReconciledAtLedger.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.True(t, config.ReconciliationRequest.ReconciledAtPayments.Equal(time.Date(2025, 1, 1, 22, 0, 0, 0, time.UTC)))
+
+	model.ReconciledAtLedger = types.StringValue("yesterday")
+	_, diags = model.CreateConfig()
+	require.Equal(t, 1, diags.ErrorsCount())
+}
+
+func TestReconciliationFromReconciliation(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	model := ReconciliationModel{
+		ReconciledAtLedger:   types.StringValue("2025-01-01T02:00:00+02:00"),
+		ReconciledAtPayments: types.StringValue("2025-01-01T00:00:00Z"),
+	}
+	diags := model.fromReconciliation(ctx, shared.Reconciliation{
+		ID:                   "reconciliation",
+		PolicyID:             "policy",
+		CreatedAt:            time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+		ReconciledAtLedger:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ReconciledAtPayments: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		Status:               "NOT_OK",
+		Error:                pointer.For("balance drift for asset USD/2"),
+		DriftBalances: map[string]*big.Int{
+			"USD/2": big.NewInt(-100),
+			"EUR/2": nil,
+		},
+	})
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "reconciliation", model.ID.ValueString())
+	require.Equal(t, "policy", model.PolicyID.ValueString())
+	require.Equal(t, "2025-01-01T02:00:00+02:00", model.ReconciledAtLedger.ValueString())
+	require.Equal(t, "2025-01-02T00:00:00Z", model.ReconciledAtPayments.ValueString())
+	require.Equal(t, "NOT_OK", model.Status.ValueString())
+	require.Equal(t, "balance drift for asset USD/2", model.Error.ValueString())
+	require.Equal(t, "2025-01-03T00:00:00Z", model.CreatedAt.ValueString())
+
+	drift := map[string]types.Number{}
+	require.False(t, model.Drift.ElementsAs(ctx, &drift, false).HasError())
+	require.Len(t, drift, 2)
+	require.True(t, drift["USD/2"].Equal(types.NumberValue(new(big.Float).SetInt64(-100))))
+	require.True(t, drift["EUR/2"].Equal(types.NumberValue(new(big.Float))))
+}
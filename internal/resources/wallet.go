@@ -0,0 +1,297 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &Wallet{}
+	_ resource.ResourceWithConfigure   = &Wallet{}
+	_ resource.ResourceWithModifyPlan  = &Wallet{}
+	_ resource.ResourceWithImportState = &Wallet{}
+)
+
+type Wallet struct {
+	store *internal.ModuleStore
+}
+
+type WalletModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Metadata  types.Map    `tfsdk:"metadata"`
+	Ledger    types.String `tfsdk:"ledger"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// metadata converts the metadata of the model into the format expected by the API.
+func (m WalletModel) metadata() map[string]string {
+	return collectionutils.ConvertMap(m.Metadata.Elements(), func(v attr.Value) string {
+		return v.(types.String).ValueString()
+	})
+}
+
+// CreateConfig converts the model into a wallet creation request.
+func (m WalletModel) CreateConfig() operations.CreateWalletRequest {
+	return operations.CreateWalletRequest{
+		CreateWalletRequest: &shared.CreateWalletRequest{
+			Name:     m.Name.ValueString(),
+			Metadata: m.metadata(),
+		},
+	}
+}
+
+// fromWallet stores the wallet returned by the API in the model.
+func (m *WalletModel) fromWallet(id, name, ledger string, metadata map[string]string, createdAt time.Time) {
+	m.ID = types.StringValue(id)
+	m.Name = types.StringValue(name)
+	m.Ledger = types.StringValue(ledger)
+	m.Metadata = types.MapValueMust(types.StringType,
+		collectionutils.ConvertMap(metadata, func(v string) attr.Value {
+			return types.StringValue(v)
+		}),
+	)
+	m.CreatedAt = types.StringValue(createdAt.UTC().Format(time.RFC3339))
+}
+
+// removedMetadata returns the sorted metadata keys of the state which are missing from the plan.
+func removedMetadata(state, plan types.Map) []string {
+	keys := []string{}
+	for key := range state.Elements() {
+		if _, ok := plan.Elements()[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func NewWallet() func() resource.Resource {
+	return func() resource.Resource {
+		return &Wallet{}
+	}
+}
+
+var SchemaWallet = schema.Schema{
+	Description: "Resource for managing a Formance Wallet. Wallets cannot be deleted, destroying the resource only removes it from the Terraform state. For advanced usage and configuration, see the [Wallets documentation](https://docs.formance.com/wallets/).",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The unique identifier of the wallet.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the wallet. Changing it creates a new wallet.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"metadata": schema.MapAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			ElementType: types.StringType,
+			Description: "Metadata associated with the wallet, stored as key-value pairs. Metadata is updated in place, the API merges it with the existing metadata so keys cannot be removed, set them to an empty value instead.",
+		},
+		"ledger": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ledger holding the accounts of the wallet.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The creation date of the wallet, in RFC 3339 format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *Wallet) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaWallet
+}
+
+// Metadata implements resource.Resource.
+func (s *Wallet) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_wallet"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *Wallet) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("wallets")
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// The API merges the metadata of a wallet, removing keys is reported at plan time instead of drifting forever.
+func (s *Wallet) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state WalletModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() || plan.Metadata.IsUnknown() {
+		return
+	}
+	// A renamed wallet is replaced, the new wallet does not inherit the metadata of the old one
+	if !plan.Name.Equal(state.Name) {
+		return
+	}
+
+	if keys := removedMetadata(state.Metadata, plan.Metadata); len(keys) > 0 {
+		res.Diagnostics.AddAttributeError(
+			path.Root("metadata"),
+			"Wallet Metadata Cannot Be Removed",
+			fmt.Sprintf("The metadata keys %s cannot be removed from the wallet %s, set them to an empty value instead.", strings.Join(keys, ", "), state.ID.ValueString()),
+		)
+	}
+}
+
+// Create implements resource.Resource.
+func (s *Wallet) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan WalletModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := s.store.Wallets().CreateWallet(ctx, plan.CreateConfig())
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	wallet := resp.CreateWalletResponse.Data
+	plan.fromWallet(wallet.ID, wallet.Name, wallet.Ledger, wallet.Metadata, wallet.CreatedAt)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read implements resource.Resource.
+func (s *Wallet) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state WalletModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.read(ctx, &state, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// read fetches the wallet of the model and stores it in the model.
+func (s *Wallet) read(ctx context.Context, m *WalletModel, diagnostics *diag.Diagnostics) {
+	resp, err := s.store.Wallets().GetWallet(ctx, operations.GetWalletRequest{
+		ID: m.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+
+	wallet := resp.ActivityGetWalletOutput.Data
+	m.fromWallet(wallet.ID, wallet.Name, wallet.Ledger, wallet.Metadata, wallet.CreatedAt)
+}
+
+// Update implements resource.Resource.
+// Only the metadata can change without replacing the wallet.
+func (s *Wallet) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan WalletModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := s.store.Wallets().UpdateWallet(ctx, operations.UpdateWalletRequest{
+		ID: plan.ID.ValueString(),
+		RequestBody: &operations.UpdateWalletRequestBody{
+			Metadata: plan.metadata(),
+		},
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	s.read(ctx, &plan, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+// The API cannot delete wallets, the wallet is only removed from the state.
+func (s *Wallet) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	var state WalletModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.AddWarning(
+		"Wallet Not Deleted",
+		fmt.Sprintf("Wallets cannot be deleted, the wallet %s was only removed from the Terraform state.", state.ID.ValueString()),
+	)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (s *Wallet) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+}
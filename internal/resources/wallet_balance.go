@@ -0,0 +1,387 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/collectionutils"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &WalletBalance{}
+	_ resource.ResourceWithConfigure      = &WalletBalance{}
+	_ resource.ResourceWithValidateConfig = &WalletBalance{}
+	_ resource.ResourceWithModifyPlan     = &WalletBalance{}
+	_ resource.ResourceWithImportState    = &WalletBalance{}
+)
+
+type WalletBalance struct {
+	store *internal.ModuleStore
+}
+
+type WalletBalanceModel struct {
+	ID        types.String `tfsdk:"id"`
+	WalletID  types.String `tfsdk:"wallet_id"`
+	Name      types.String `tfsdk:"name"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Priority  types.Int64  `tfsdk:"priority"`
+	Assets    types.Map    `tfsdk:"assets"`
+}
+
+// walletBalanceID returns the identifier of a balance in the Terraform state.
+func walletBalanceID(walletID, name string) string {
+	return walletID + "/" + name
+}
+
+// CreateConfig converts the model into a balance creation request.
+func (m WalletBalanceModel) CreateConfig() (operations.CreateBalanceRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	balance := shared.CreateBalanceRequest{
+		Name: m.Name.ValueString(),
+	}
+	if !m.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid Date",
+				fmt.Sprintf("The expires_at attribute must be a RFC 3339 date, such as 2026-12-31T23:59:59Z: %s", err),
+			)
+		} else {
+			balance.ExpiresAt = &expiresAt
+		}
+	}
+	if !m.Priority.IsNull() {
+		balance.Priority = big.NewInt(m.Priority.ValueInt64())
+	}
+
+	return operations.CreateBalanceRequest{
+		ID:                   m.WalletID.ValueString(),
+		CreateBalanceRequest: &balance,
+	}, diags
+}
+
+// fromBalance stores the balance returned by the API in the model.
+// The expiry date is kept as configured when it denotes the same instant.
+func (m *WalletBalanceModel) fromBalance(ctx context.Context, balance shared.BalanceWithAssets) diag.Diagnostics {
+	m.ID = types.StringValue(walletBalanceID(m.WalletID.ValueString(), balance.Name))
+	m.Name = types.StringValue(balance.Name)
+	if balance.ExpiresAt != nil {
+		m.ExpiresAt = keepEqualInstant(m.ExpiresAt, *balance.ExpiresAt)
+	} else {
+		m.ExpiresAt = types.StringNull()
+	}
+	// The API returns a zero priority when none is set, it is kept null when not configured
+	if balance.Priority != nil && (balance.Priority.Sign() != 0 || !m.Priority.IsNull()) {
+		m.Priority = types.Int64Value(balance.Priority.Int64())
+	} else {
+		m.Priority = types.Int64Null()
+	}
+
+	assets := collectionutils.ConvertMap(balance.Assets, func(amount *big.Int) *big.Float {
+		value := new(big.Float)
+		if amount != nil {
+			value.SetInt(amount)
+		}
+		return value
+	})
+
+	var diags diag.Diagnostics
+	m.Assets, diags = types.MapValueFrom(ctx, types.NumberType, assets)
+	return diags
+}
+
+// sameInstant reports whether two RFC 3339 dates denote the same instant, such as 2026-01-01T01:00:00+01:00 and 2026-01-01T00:00:00Z.
+func sameInstant(a, b types.String) bool {
+	if a.Equal(b) {
+		return true
+	}
+	first, err := time.Parse(time.RFC3339, a.ValueString())
+	if err != nil || a.IsNull() || b.IsNull() {
+		return false
+	}
+	return keepEqualInstant(b, first).Equal(b)
+}
+
+func NewWalletBalance() func() resource.Resource {
+	return func() resource.Resource {
+		return &WalletBalance{}
+	}
+}
+
+var SchemaWalletBalance = schema.Schema{
+	Description: "Resource for managing a named balance of a Formance Wallet, such as a promotional balance with an expiry date. Balances cannot be updated nor deleted, the expiry date and the priority cannot change and destroying the resource only removes it from the Terraform state. For advanced usage and configuration, see the [Wallets documentation](https://docs.formance.com/wallets/).",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The identifier of the balance, in the `<wallet_id>/<name>` format.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"wallet_id": schema.StringAttribute{
+			Required:    true,
+			Description: "The unique identifier of the wallet holding the balance.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the balance, unique within the wallet. The `main` balance exists on every wallet and cannot be managed.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"expires_at": schema.StringAttribute{
+			Optional:    true,
+			Description: "The expiry date of the balance, in RFC 3339 format. Expired balances are no longer used by debits.",
+		},
+		"priority": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The priority of the balance, balances with a higher priority are debited first.",
+		},
+		"assets": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.NumberType,
+			Description: "The current amounts of the balance per asset, such as `{ \"USD/2\" = 1000 }`. Refreshed on every read.",
+		},
+	},
+}
+
+// Schema implements resource.Resource.
+func (s *WalletBalance) Schema(ctx context.Context, req resource.SchemaRequest, res *resource.SchemaResponse) {
+	res.Schema = SchemaWalletBalance
+}
+
+// Metadata implements resource.Resource.
+func (s *WalletBalance) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = req.ProviderTypeName + "_wallet_balance"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (s *WalletBalance) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	store, ok := req.ProviderData.(internal.Store)
+	if !ok {
+		res.Diagnostics.AddError(
+			"Invalid Provider Data",
+			fmt.Sprintf("Expected internal.Store, got: %T", req.ProviderData),
+		)
+		return
+	}
+
+	s.store = store.NewModuleStore("wallets")
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (s *WalletBalance) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	var config WalletBalanceModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Name.ValueString() == "main" {
+		res.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Balance Name",
+			"The main balance exists on every wallet and cannot be managed.",
+		)
+	}
+
+	if config.ExpiresAt.IsUnknown() {
+		return
+	}
+	_, diags := config.CreateConfig()
+	res.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Balances cannot be updated, changing the expiry date or the priority of an existing balance is reported at plan time.
+func (s *WalletBalance) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state WalletBalanceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	// A balance moved to another wallet or renamed is replaced, so it can have any expiry date or priority
+	if !plan.Name.Equal(state.Name) || !plan.WalletID.Equal(state.WalletID) {
+		return
+	}
+
+	changed := []string{}
+	if !plan.ExpiresAt.IsUnknown() && !sameInstant(plan.ExpiresAt, state.ExpiresAt) {
+		changed = append(changed, "expires_at")
+	}
+	if !plan.Priority.IsUnknown() && !plan.Priority.Equal(state.Priority) {
+		changed = append(changed, "priority")
+	}
+	if len(changed) > 0 {
+		res.Diagnostics.AddError(
+			"Wallet Balance Cannot Be Updated",
+			fmt.Sprintf("The %s of the balance %s cannot change, declare a balance with a new name instead.", strings.Join(changed, " and "), state.ID.ValueString()),
+		)
+	}
+}
+
+// Create implements resource.Resource.
+// A balance which already exists is reported, so that it gets imported rather than silently adopted.
+func (s *WalletBalance) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	var plan WalletBalanceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	config, diags := plan.CreateConfig()
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	walletsSdk := s.store.Wallets()
+	balances, err := walletsSdk.ListBalances(ctx, operations.ListBalancesRequest{
+		ID: plan.WalletID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+	for _, balance := range balances.ListBalancesResponse.Cursor.Data {
+		if balance.Name == plan.Name.ValueString() {
+			res.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Wallet Balance Already Exists",
+				fmt.Sprintf("The balance %s already exists, import it with the ID %s.", balance.Name, walletBalanceID(plan.WalletID.ValueString(), balance.Name)),
+			)
+			return
+		}
+	}
+
+	if _, err := walletsSdk.CreateBalance(ctx, config); err != nil {
+		sdk.HandleStackError(ctx, err, &res.Diagnostics)
+		return
+	}
+
+	s.read(ctx, &plan, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read implements resource.Resource.
+func (s *WalletBalance) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	var state WalletBalanceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.read(ctx, &state, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// read fetches the balance of the model and stores it in the model.
+func (s *WalletBalance) read(ctx context.Context, m *WalletBalanceModel, diagnostics *diag.Diagnostics) {
+	resp, err := s.store.Wallets().GetBalance(ctx, operations.GetBalanceRequest{
+		ID:          m.WalletID.ValueString(),
+		BalanceName: m.Name.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+
+	diagnostics.Append(m.fromBalance(ctx, resp.GetBalanceResponse.Data)...)
+}
+
+// Update implements resource.Resource.
+// Changes are rejected at plan time, only the format of the expiry date can change and the balance is read again.
+func (s *WalletBalance) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	var plan WalletBalanceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	s.read(ctx, &plan, &res.Diagnostics)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete implements resource.Resource.
+// The API cannot delete balances, the balance is only removed from the state.
+func (s *WalletBalance) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	var state WalletBalanceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	res.Diagnostics.AddWarning(
+		"Wallet Balance Not Deleted",
+		fmt.Sprintf("Wallet balances cannot be deleted, the balance %s was only removed from the Terraform state.", state.ID.ValueString()),
+	)
+}
+
+// ImportState implements resource.ResourceWithImportState.
+// Balances are imported with the `<wallet_id>/<name>` identifier.
+func (s *WalletBalance) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	walletID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || walletID == "" || name == "" {
+		res.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The import ID must be in the <wallet_id>/<name> format, got: %s", req.ID),
+		)
+		return
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("wallet_id"), walletID)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
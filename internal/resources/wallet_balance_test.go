@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletBalanceCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := WalletBalanceModel{
+		WalletID:  types.StringValue("wallet"),
+		Name:      types.StringValue("promo-2026"),
+		ExpiresAt: types.StringValue("2026-12-31T23:59:59Z"),
+		Priority:  types.Int64Value(10),
+	}
+	config, diags := model.CreateConfig()
+	require.False(t, diags.HasError())
+	require.Equal(t, "wallet", config.ID)
+	require.Equal(t, "promo-2026", config.CreateBalanceRequest.Name)
+	require.Equal(t, time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC), *config.CreateBalanceRequest.ExpiresAt)
+	require.Equal(t, big.NewInt(10), config.CreateBalanceRequest.Priority)
+
+	model.ExpiresAt = types.StringValue("2026-12-31")
+	_, diags = model.CreateConfig()
+	require.True(t, diags.HasError())
+
+	model.ExpiresAt = types.StringNull()
+	model.Priority = types.Int64Null()
+	config, diags = model.CreateConfig()
+	require.False(t, diags.HasError())
+	require.Nil(t, config.CreateBalanceRequest.ExpiresAt)
+	require.Nil(t, config.CreateBalanceRequest.Priority)
+}
+
+func TestWalletBalanceFromBalance(t *testing.T) {
+	t.Parallel()
+
+	amount, _ := new(big.Int).SetString("100000000000000000000001", 10)
+	model := WalletBalanceModel{
+		WalletID:  types.StringValue("wallet"),
+		ExpiresAt: types.StringValue("2027-01-01T01:00:00+01:00"),
+		Priority:  types.Int64Null(),
+	}
+	diags := model.fromBalance(context.Background(), shared.BalanceWithAssets{
+		Name:      "promo-2026",
+		ExpiresAt: pointer.For(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Priority:  big.NewInt(0),
+		Assets:    map[string]*big.Int{"USD/2": amount},
+	})
+	require.False(t, diags.HasError())
+	require.Equal(t, "wallet/promo-2026", model.ID.ValueString())
+	require.Equal(t, "2027-01-01T01:00:00+01:00", model.ExpiresAt.ValueString())
+	// A zero priority is kept null when not configured
+	require.True(t, model.Priority.IsNull())
+	require.Equal(t, types.MapValueMust(types.NumberType, map[string]attr.Value{
+		"USD/2": types.NumberValue(new(big.Float).SetInt(amount)),
+	}), model.Assets)
+
+	model.Priority = types.Int64Value(0)
+	diags = model.fromBalance(context.Background(), shared.BalanceWithAssets{
+		Name:     "promo-2026",
+		Priority: big.NewInt(0),
+	})
+	require.False(t, diags.HasError())
+	require.Equal(t, types.Int64Value(0), model.Priority)
+}
+
+func TestWalletBalanceSameInstant(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, sameInstant(types.StringNull(), types.StringNull()))
+	require.True(t, sameInstant(types.StringValue("2027-01-01T01:00:00+01:00"), types.StringValue("2027-01-01T00:00:00Z")))
+	require.False(t, sameInstant(types.StringValue("2027-01-01T00:00:00Z"), types.StringValue("2028-01-01T00:00:00Z")))
+	require.False(t, sameInstant(types.StringValue("2027-01-01T00:00:00Z"), types.StringNull()))
+	require.False(t, sameInstant(types.StringNull(), types.StringValue("2027-01-01T00:00:00Z")))
+}
@@ -0,0 +1,53 @@
+package resources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalletCreateConfig(t *testing.T) {
+	t.Parallel()
+
+	model := WalletModel{
+		Name: types.StringValue("fees"),
+		Metadata: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"purpose": types.StringValue("fees"),
+		}),
+	}
+	config := model.CreateConfig()
+	require.Equal(t, "fees", config.CreateWalletRequest.Name)
+	require.Equal(t, map[string]string{"purpose": "fees"}, config.CreateWalletRequest.Metadata)
+}
+
+func TestWalletFromWallet(t *testing.T) {
+	t.Parallel()
+
+	var model WalletModel
+	model.fromWallet("wallet", "fees", "main", nil, time.Date(2025, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)))
+	require.Equal(t, WalletModel{
+		ID:        types.StringValue("wallet"),
+		Name:      types.StringValue("fees"),
+		Ledger:    types.StringValue("main"),
+		Metadata:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		CreatedAt: types.StringValue("2025-01-01T00:00:00Z"),
+	}, model)
+}
+
+func TestWalletRemovedMetadata(t *testing.T) {
+	t.Parallel()
+
+	state := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"purpose": types.StringValue("fees"),
+		"owner":   types.StringValue("finance"),
+		"team":    types.StringValue("billing"),
+	})
+	plan := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"purpose": types.StringValue("escrow"),
+	})
+	require.Equal(t, []string{"owner", "team"}, removedMetadata(state, plan))
+	require.Empty(t, removedMetadata(plan, state))
+}
@@ -3,6 +3,8 @@ package resources
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
@@ -10,9 +12,13 @@ import (
 	"github.com/formancehq/go-libs/v3/pointer"
 	"github.com/formancehq/terraform-provider-stack/internal"
 	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -21,6 +27,8 @@ var (
 	_ resource.ResourceWithConfigure        = &Webhooks{}
 	_ resource.ResourceWithConfigValidators = &Webhooks{}
 	_ resource.ResourceWithValidateConfig   = &Webhooks{}
+	_ resource.ResourceWithModifyPlan       = &Webhooks{}
+	_ resource.ResourceWithImportState      = &Webhooks{}
 )
 
 type Webhooks struct {
@@ -28,10 +36,104 @@ type Webhooks struct {
 }
 
 type WebhooksModel struct {
-	ID         types.String `tfsdk:"id"`
-	Endpoint   types.String `tfsdk:"endpoint"`
-	EventTypes types.List   `tfsdk:"event_types"`
-	Secret     types.String `tfsdk:"secret"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	EventTypes      types.Set    `tfsdk:"event_types"`
+	Secret          types.String `tfsdk:"secret"`
+	SecretWO        types.String `tfsdk:"secret_wo"`
+	SecretVersion   types.Int64  `tfsdk:"secret_version"`
+	EffectiveSecret types.String `tfsdk:"effective_secret"`
+	Active          types.Bool   `tfsdk:"active"`
+
+	VerifyOnApply           types.Bool   `tfsdk:"verify_on_apply"`
+	FailOnVerificationError types.Bool   `tfsdk:"fail_on_verification_error"`
+	VerificationStatusCode  types.Int64  `tfsdk:"verification_status_code"`
+	VerifiedAt              types.String `tfsdk:"verified_at"`
+}
+
+// configChanged reports whether the webhook configuration differs from the state, the secret and the activation excluded.
+func (m WebhooksModel) configChanged(state WebhooksModel) bool {
+	return !m.Endpoint.Equal(state.Endpoint) ||
+		!m.Name.Equal(state.Name) ||
+		!sameEventTypes(m.eventTypes(), state.eventTypes())
+}
+
+// eventTypes returns the known event types of the model.
+func (m WebhooksModel) eventTypes() []string {
+	eventTypes := []string{}
+	for _, v := range m.EventTypes.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsUnknown() && !s.IsNull() {
+			eventTypes = append(eventTypes, s.ValueString())
+		}
+	}
+	return eventTypes
+}
+
+// setEventTypes stores the event types returned by the API, which lower-cases them.
+// The casing of the event types already in the model is kept so that it matches the configuration.
+func (m *WebhooksModel) setEventTypes(eventTypes []string) {
+	known := m.eventTypes()
+	m.EventTypes = types.SetValueMust(types.StringType, collectionutils.Map(eventTypes, func(eventType string) attr.Value {
+		for _, k := range known {
+			if strings.EqualFold(k, eventType) {
+				return types.StringValue(k)
+			}
+		}
+		return types.StringValue(eventType)
+	}))
+}
+
+// containsEventType reports whether the event type is in the list, ignoring case.
+func containsEventType(eventTypes []string, eventType string) bool {
+	for _, e := range eventTypes {
+		if strings.EqualFold(e, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameEventTypes reports whether both lists hold the same event types, ignoring case.
+func sameEventTypes(a, b []string) bool {
+	for _, e := range a {
+		if !containsEventType(b, e) {
+			return false
+		}
+	}
+	for _, e := range b {
+		if !containsEventType(a, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// secretChanged reports whether the signing secret must be changed to reach the plan from the state.
+func (m WebhooksModel) secretChanged(state WebhooksModel) bool {
+	return !m.Secret.Equal(state.Secret) || !m.SecretVersion.Equal(state.SecretVersion)
+}
+
+// configSecret returns the secret to send to the API, the write-only secret of the configuration taking precedence.
+// An empty secret lets the API generate one.
+func (m WebhooksModel) configSecret(conf WebhooksModel) string {
+	if !conf.SecretWO.IsNull() {
+		return conf.SecretWO.ValueString()
+	}
+	return m.Secret.ValueString()
+}
+
+// plannedEffectiveSecret returns the secret the API will use after the secret is set from the configuration.
+// It is null for write-only secrets, which must not be stored, and unknown when the API generates it.
+func (m WebhooksModel) plannedEffectiveSecret(conf WebhooksModel) types.String {
+	switch {
+	case !conf.SecretWO.IsNull():
+		return types.StringNull()
+	case m.Secret.IsUnknown() || m.Secret.ValueString() == "":
+		return types.StringUnknown()
+	default:
+		return m.Secret
+	}
 }
 
 func NewWebhooks() func() resource.Resource {
@@ -47,19 +149,64 @@ var SchemaWebhooks = schema.Schema{
 			Computed:    true,
 			Description: "The unique identifier of the webhook configuration.",
 		},
+		"name": schema.StringAttribute{
+			Optional:    true,
+			Description: "A human readable name of the webhook configuration. It is local-only: it is sent to the API but never returned by it, so changes made outside of Terraform are not detected and configurations cannot be imported by name. Import them by ID or by endpoint URL instead.",
+		},
 		"endpoint": schema.StringAttribute{
 			Required:    true,
 			Description: "The endpoint to which webhooks will be sent.",
 		},
-		"event_types": schema.ListAttribute{
+		"event_types": schema.SetAttribute{
 			Required:    true,
 			ElementType: types.StringType,
-			Description: "The types of events that will trigger webhooks.",
+			Description: "The types of events that will trigger webhooks, such as `ledger.committed_transactions`. Event types are compared case-insensitively. At plan time, a warning is reported for the event types missing from the catalog of the provider or published by modules which are not installed on the stack.",
 		},
 		"secret": schema.StringAttribute{
 			Optional:    true,
 			Sensitive:   true,
-			Description: "The secret used to sign webhook payloads. If not provided, a secret will be generated by the API. Advanced usage: See [Webhooks documentation](https://docs.formance.com/webhooks/) for security best practices.",
+			Description: "The secret used to sign webhook payloads. If not provided, a secret will be generated by the API. Changing it rotates the secret of the endpoint. The value is stored in the state, prefer `secret_wo` with Terraform 1.11 and later. Conflicts with `secret_wo`. Advanced usage: See [Webhooks documentation](https://docs.formance.com/webhooks/) for security best practices.",
+		},
+		"secret_wo": schema.StringAttribute{
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: "The write-only secret used to sign webhook payloads. The value is sent to the API but never stored in the plan or the state. Bump `secret_version` to rotate it. Conflicts with `secret`.",
+		},
+		"secret_version": schema.Int64Attribute{
+			Optional:    true,
+			Description: "An arbitrary version of the secret. Changing it rotates the secret of the endpoint with the current `secret_wo`, or with a secret generated by the API when no secret is configured.",
+		},
+		"effective_secret": schema.StringAttribute{
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The secret used by the API to sign webhook payloads, including the secret generated by the API when none is configured. Null when the secret is given through `secret_wo`.",
+		},
+		"active": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Whether webhooks are sent to the endpoint. The server deactivates endpoints failing repeatedly, such endpoints are reactivated on the next apply. Defaults to `true`.",
+		},
+		"verify_on_apply": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether a test event is sent to the endpoint after each create or update of the configuration. A non-2xx response is reported as a warning, unless `fail_on_verification_error` is set. Defaults to `false`.",
+		},
+		"fail_on_verification_error": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the apply fails when the endpoint does not answer the test event with a 2xx status. Requires `verify_on_apply`. Defaults to `false`.",
+		},
+		"verification_status_code": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The HTTP status returned by the endpoint to the last test event sent on apply.",
+		},
+		"verified_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "The date of the last test event sent on apply, in RFC 3339 format.",
 		},
 	},
 }
@@ -77,11 +224,107 @@ func (s *Webhooks) ValidateConfig(ctx context.Context, req resource.ValidateConf
 		return
 	}
 
+	if config.FailOnVerificationError.ValueBool() && !config.VerifyOnApply.IsUnknown() && !config.VerifyOnApply.ValueBool() {
+		res.Diagnostics.AddAttributeError(
+			path.Root("fail_on_verification_error"),
+			"Verification Disabled",
+			"`fail_on_verification_error` has no effect unless `verify_on_apply` is set.",
+		)
+	}
+
+	eventTypes := config.eventTypes()
+	for i, eventType := range eventTypes {
+		if containsEventType(eventTypes[:i], eventType) {
+			res.Diagnostics.AddAttributeError(
+				path.Root("event_types").AtSetValue(types.StringValue(eventType)),
+				"Duplicate Webhook Event Type",
+				fmt.Sprintf("The event type '%s' is listed several times with a different case, event types are case-insensitive.", eventType),
+			)
+		}
+	}
 }
 
 // ConfigValidators implements resource.ResourceWithConfigValidators.
 func (s *Webhooks) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
-	return nil
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("secret"),
+			path.MatchRoot("secret_wo"),
+		),
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// The event types which are not in the state are checked against the events published by the modules of the stack.
+func (s *Webhooks) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan WebhooksModel
+	var conf WebhooksModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	effectiveSecret := plan.plannedEffectiveSecret(conf)
+	var state WebhooksModel
+	if !req.State.Raw.IsNull() {
+		res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		if !plan.secretChanged(state) {
+			effectiveSecret = state.EffectiveSecret
+		}
+	}
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("effective_secret"), effectiveSecret)...)
+
+	// The verification attributes only change when a test event is sent, that is when the configuration is applied.
+	if !plan.VerifyOnApply.ValueBool() {
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("verification_status_code"), state.VerificationStatusCode)...)
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root("verified_at"), state.VerifiedAt)...)
+	}
+
+	stateEventTypes := state.eventTypes()
+	eventTypes := []string{}
+	for _, eventType := range plan.eventTypes() {
+		if !containsEventType(stateEventTypes, eventType) {
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+	if len(eventTypes) > 0 && s.store != nil {
+		s.checkEventTypes(ctx, eventTypes, &res.Diagnostics)
+	}
+}
+
+func (s *Webhooks) checkEventTypes(ctx context.Context, eventTypes []string, diagnostics *diag.Diagnostics) {
+	resp, err := s.store.GetVersions(ctx)
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+
+	for _, eventType := range eventTypes {
+		message, unknown := checkEventType(eventType, resp.GetVersionsResponse.Versions)
+		switch {
+		case message == "":
+		case unknown:
+			diagnostics.AddAttributeWarning(
+				path.Root("event_types").AtSetValue(types.StringValue(eventType)),
+				"Unknown Webhook Event Type",
+				message,
+			)
+		default:
+			diagnostics.AddAttributeWarning(
+				path.Root("event_types").AtSetValue(types.StringValue(eventType)),
+				"Module Not Installed",
+				message,
+			)
+		}
+	}
 }
 
 // Configure implements resource.ResourceWithConfigure.
@@ -106,19 +349,20 @@ func (s *Webhooks) Configure(ctx context.Context, req resource.ConfigureRequest,
 // Create implements resource.Resource.
 func (s *Webhooks) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
 	var plan WebhooksModel
+	var conf WebhooksModel
 	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
 	if res.Diagnostics.HasError() {
 		return
 	}
 
 	config := shared.ConfigUser{}
-	if plan.Secret.ValueString() != "" {
-		config.Secret = pointer.For(plan.Secret.ValueString())
+	if secret := plan.configSecret(conf); secret != "" {
+		config.Secret = pointer.For(secret)
 	}
+	config.Name = plan.Name.ValueStringPointer()
 	config.Endpoint = plan.Endpoint.ValueString()
-	config.EventTypes = collectionutils.Map(plan.EventTypes.Elements(), func(v attr.Value) string {
-		return v.(types.String).ValueString()
-	})
+	config.EventTypes = plan.eventTypes()
 
 	sdkWebhooks := s.store.Webhooks()
 	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
@@ -130,14 +374,96 @@ func (s *Webhooks) Create(ctx context.Context, req resource.CreateRequest, res *
 		sdk.HandleStackError(ctx, err, &res.Diagnostics)
 		return
 	}
+	active := plan.Active.ValueBool()
 	data := resp.ConfigResponse.Data
 	plan.ID = types.StringValue(data.ID)
 	plan.Endpoint = types.StringValue(data.Endpoint)
-	plan.EventTypes = types.ListValueMust(types.StringType, collectionutils.Map(data.EventTypes, func(s string) attr.Value {
-		return types.StringValue(s)
-	}))
+	plan.setEventTypes(data.EventTypes)
+	plan.Active = types.BoolValue(data.Active)
+	plan.EffectiveSecret = types.StringNull()
+	if conf.SecretWO.IsNull() {
+		plan.EffectiveSecret = types.StringValue(data.Secret)
+	}
+
+	plan.VerificationStatusCode = types.Int64Null()
+	plan.VerifiedAt = types.StringNull()
 
+	// The configuration is saved before its deactivation so that it is tracked even if the deactivation fails.
 	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Active != active {
+		s.setActive(ctx, &plan, active, &res.Diagnostics)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.VerifyOnApply.ValueBool() {
+		s.verify(ctx, &plan, &res.Diagnostics)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+	}
+}
+
+// verify sends a test event to the endpoint and records the response in the model.
+// A non-2xx response is reported as a warning, or as an error when the model asks to fail on verification errors.
+func (s *Webhooks) verify(ctx context.Context, m *WebhooksModel, diagnostics *diag.Diagnostics) {
+	resp, err := s.store.Webhooks().TestConfig(ctx, operations.TestConfigRequest{
+		ID: m.ID.ValueString(),
+	})
+	if err != nil {
+		sdk.HandleStackError(ctx, err, diagnostics)
+		return
+	}
+
+	attempt := resp.AttemptResponse.Data
+	m.VerificationStatusCode = types.Int64Value(attempt.StatusCode)
+	m.VerifiedAt = types.StringValue(attempt.CreatedAt.UTC().Format(time.RFC3339))
+	if attempt.StatusCode >= 200 && attempt.StatusCode < 300 {
+		return
+	}
+
+	summary := "Webhook Endpoint Verification Failed"
+	detail := fmt.Sprintf("The endpoint %s answered the test event with the HTTP status %d.", m.Endpoint.ValueString(), attempt.StatusCode)
+	if m.FailOnVerificationError.ValueBool() {
+		diagnostics.AddAttributeError(path.Root("endpoint"), summary, detail)
+		return
+	}
+	diagnostics.AddAttributeWarning(path.Root("endpoint"), summary, detail)
+}
+
+// setActive activates or deactivates the webhook configuration and stores the resulting activation in the model.
+func (s *Webhooks) setActive(ctx context.Context, m *WebhooksModel, active bool, diagnostics *diag.Diagnostics) {
+	sdkWebhooks := s.store.Webhooks()
+	var config *shared.ConfigResponse
+	if active {
+		resp, err := sdkWebhooks.ActivateConfig(ctx, operations.ActivateConfigRequest{
+			ID: m.ID.ValueString(),
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, diagnostics)
+			return
+		}
+		config = resp.ConfigResponse
+	} else {
+		resp, err := sdkWebhooks.DeactivateConfig(ctx, operations.DeactivateConfigRequest{
+			ID: m.ID.ValueString(),
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, diagnostics)
+			return
+		}
+		config = resp.ConfigResponse
+	}
+
+	// The API answers without a body when the configuration is already in the requested state.
+	m.Active = types.BoolValue(active)
+	if config != nil {
+		m.Active = types.BoolValue(config.Data.Active)
+	}
 }
 
 // Delete implements resource.Resource.
@@ -199,9 +525,19 @@ func (s *Webhooks) Read(ctx context.Context, req resource.ReadRequest, res *reso
 	config := data[0]
 	state.ID = types.StringValue(config.ID)
 	state.Endpoint = types.StringValue(config.Endpoint)
-	state.EventTypes = types.ListValueMust(types.StringType, collectionutils.Map(config.EventTypes, func(s string) attr.Value {
-		return types.StringValue(s)
-	}))
+	state.setEventTypes(config.EventTypes)
+	state.Active = types.BoolValue(config.Active)
+	// Imported configurations have no verification settings yet.
+	if state.VerifyOnApply.IsNull() {
+		state.VerifyOnApply = types.BoolValue(false)
+	}
+	if state.FailOnVerificationError.IsNull() {
+		state.FailOnVerificationError = types.BoolValue(false)
+	}
+	// Only a secret captured by Terraform is refreshed, write-only secrets are never stored.
+	if !state.EffectiveSecret.IsNull() && config.Secret != "" {
+		state.EffectiveSecret = types.StringValue(config.Secret)
+	}
 
 	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
 }
@@ -210,34 +546,106 @@ func (s *Webhooks) Read(ctx context.Context, req resource.ReadRequest, res *reso
 func (s *Webhooks) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
 	var plan WebhooksModel
 	var state WebhooksModel
+	var conf WebhooksModel
 	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	res.Diagnostics.Append(req.Config.Get(ctx, &conf)...)
 	if res.Diagnostics.HasError() {
 		return
 	}
 
 	plan.ID = state.ID
-	config := operations.UpdateConfigRequest{
-		ID: state.ID.ValueString(),
-	}
-	if plan.Secret.ValueString() != "" {
-		config.ConfigUser.Secret = pointer.For(plan.Secret.ValueString())
-	}
-	config.ConfigUser.Endpoint = plan.Endpoint.ValueString()
-	config.ConfigUser.EventTypes = collectionutils.Map(plan.EventTypes.Elements(), func(v attr.Value) string {
-		return v.(types.String).ValueString()
-	})
-
 	s.store.CheckModuleHealth(ctx, &res.Diagnostics)
 	if res.Diagnostics.HasError() {
 		return
 	}
 	sdkWebhooks := s.store.Webhooks()
-	_, err := sdkWebhooks.UpdateConfig(ctx, config)
-	if err != nil {
-		sdk.HandleStackError(ctx, err, &res.Diagnostics)
-		return
+
+	if plan.configChanged(state) {
+		config := operations.UpdateConfigRequest{
+			ID: state.ID.ValueString(),
+		}
+		config.ConfigUser.Name = plan.Name.ValueStringPointer()
+		config.ConfigUser.Endpoint = plan.Endpoint.ValueString()
+		config.ConfigUser.EventTypes = plan.eventTypes()
+
+		_, err := sdkWebhooks.UpdateConfig(ctx, config)
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+	}
+
+	if plan.secretChanged(state) {
+		resp, err := sdkWebhooks.ChangeConfigSecret(ctx, operations.ChangeConfigSecretRequest{
+			ID: state.ID.ValueString(),
+			ConfigChangeSecret: &shared.ConfigChangeSecret{
+				Secret: plan.configSecret(conf),
+			},
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+		plan.EffectiveSecret = types.StringNull()
+		if conf.SecretWO.IsNull() {
+			plan.EffectiveSecret = types.StringValue(resp.ConfigResponse.Data.Secret)
+		}
+	} else {
+		plan.EffectiveSecret = state.EffectiveSecret
+	}
+
+	if !plan.Active.Equal(state.Active) {
+		s.setActive(ctx, &plan, plan.Active.ValueBool(), &res.Diagnostics)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.VerificationStatusCode = state.VerificationStatusCode
+	plan.VerifiedAt = state.VerifiedAt
+	if plan.VerifyOnApply.ValueBool() {
+		s.verify(ctx, &plan, &res.Diagnostics)
 	}
 
 	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
 }
+
+// ImportState implements resource.ResourceWithImportState.
+// Configurations are imported by ID, or by endpoint URL when a single configuration targets it.
+// The API does not return the names of the configurations, so they cannot be used to import them.
+func (s *Webhooks) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	id := req.ID
+	if strings.Contains(id, "://") {
+		s.store.CheckModuleHealth(ctx, &res.Diagnostics)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		resp, err := s.store.Webhooks().GetManyConfigs(ctx, operations.GetManyConfigsRequest{
+			Endpoint: pointer.For(id),
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, &res.Diagnostics)
+			return
+		}
+
+		configs := resp.ConfigsResponse.Cursor.Data
+		switch {
+		case len(configs) == 0:
+			res.Diagnostics.AddError(
+				"Webhook Configuration Not Found",
+				fmt.Sprintf("No webhook configuration found for the endpoint %s.", id),
+			)
+			return
+		case len(configs) > 1:
+			res.Diagnostics.AddError(
+				"Ambiguous Webhook Endpoint",
+				fmt.Sprintf("Found %d webhook configurations for the endpoint %s, import the configuration by ID instead.", len(configs), id),
+			)
+			return
+		}
+		id = configs[0].ID
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhooksSecret(t *testing.T) {
+	t.Parallel()
+
+	state := WebhooksModel{
+		Secret:          types.StringNull(),
+		SecretVersion:   types.Int64Null(),
+		EffectiveSecret: types.StringValue("generated"),
+	}
+
+	type testCase struct {
+		name            string
+		plan            WebhooksModel
+		conf            WebhooksModel
+		changed         bool
+		secret          string
+		effectiveSecret types.String
+	}
+
+	for _, tc := range []testCase{
+		{
+			name:            "generated",
+			plan:            WebhooksModel{Secret: types.StringNull(), SecretVersion: types.Int64Null()},
+			conf:            WebhooksModel{SecretWO: types.StringNull()},
+			effectiveSecret: types.StringUnknown(),
+		},
+		{
+			name:            "regenerated",
+			plan:            WebhooksModel{Secret: types.StringNull(), SecretVersion: types.Int64Value(1)},
+			conf:            WebhooksModel{SecretWO: types.StringNull()},
+			changed:         true,
+			effectiveSecret: types.StringUnknown(),
+		},
+		{
+			name:            "plain",
+			plan:            WebhooksModel{Secret: types.StringValue("plain"), SecretVersion: types.Int64Null()},
+			conf:            WebhooksModel{SecretWO: types.StringNull()},
+			changed:         true,
+			secret:          "plain",
+			effectiveSecret: types.StringValue("plain"),
+		},
+		{
+			name:            "write-only",
+			plan:            WebhooksModel{Secret: types.StringNull(), SecretVersion: types.Int64Value(1)},
+			conf:            WebhooksModel{SecretWO: types.StringValue("write-only")},
+			changed:         true,
+			secret:          "write-only",
+			effectiveSecret: types.StringNull(),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.changed, tc.plan.secretChanged(state))
+			require.Equal(t, tc.secret, tc.plan.configSecret(tc.conf))
+			require.True(t, tc.effectiveSecret.Equal(tc.plan.plannedEffectiveSecret(tc.conf)))
+		})
+	}
+}
+
+func TestWebhooksVerify(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name       string
+		statusCode int64
+		fail       bool
+		warnings   int
+		errors     int
+	}
+
+	for _, tc := range []testCase{
+		{name: "success", statusCode: http.StatusNoContent},
+		{name: "failure reported", statusCode: http.StatusInternalServerError, warnings: 1},
+		{name: "failure enforced", statusCode: http.StatusNotFound, fail: true, errors: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			stackSdk := sdk.NewMockStackSdkImpl(ctrl)
+			webhooksSdk := sdk.NewMockWebhooksSdkImpl(ctrl)
+			stackSdk.EXPECT().Webhooks().Return(webhooksSdk).AnyTimes()
+			webhooksSdk.EXPECT().TestConfig(gomock.Any(), operations.TestConfigRequest{
+				ID: "config",
+			}).Return(&operations.TestConfigResponse{
+				AttemptResponse: &shared.AttemptResponse{
+					Data: shared.Attempt{
+						StatusCode: tc.statusCode,
+						CreatedAt:  time.Date(2025, 1, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+					},
+				},
+			}, nil)
+
+			webhooks := &Webhooks{store: &internal.ModuleStore{StackSdkImpl: stackSdk}}
+			model := WebhooksModel{
+				ID:                      types.StringValue("config"),
+				Endpoint:                types.StringValue("https://example.com/webhooks"),
+				FailOnVerificationError: types.BoolValue(tc.fail),
+			}
+
+			var diags diag.Diagnostics
+			webhooks.verify(logging.TestingContext(), &model, &diags)
+			require.Equal(t, tc.warnings, diags.WarningsCount())
+			require.Equal(t, tc.errors, diags.ErrorsCount())
+			require.Equal(t, tc.statusCode, model.VerificationStatusCode.ValueInt64())
+			require.Equal(t, "2025-01-01T00:00:00Z", model.VerifiedAt.ValueString())
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"golang.org/x/mod/semver"
+)
+
+// webhookEventType is an event published by a module of the stack, since the given module version.
+type webhookEventType struct {
+	name  string
+	since string
+}
+
+// webhookEventTypes is the catalog of the events which can be sent to webhooks, indexed by module.
+// Webhook event types are the lower-cased `<module>.<event>` names of these events.
+// The OpenAPI specifications of the modules do not describe their events, the catalog is maintained by hand
+// and may lag behind the modules, so the event types missing from it are only reported as warnings.
+var webhookEventTypes = map[string][]webhookEventType{
+	"ledger": {
+		{name: "committed_transactions"},
+		{name: "saved_metadata"},
+		{name: "reverted_transaction"},
+		{name: "deleted_metadata", since: "v2.0.0"},
+	},
+	"payments": {
+		{name: "saved_payment"},
+		{name: "saved_account"},
+		{name: "saved_balances"},
+		{name: "saved_bank_account"},
+		{name: "saved_transfer_initiation"},
+		{name: "deleted_transfer_initiation"},
+		{name: "saved_pool"},
+		{name: "deleted_pool"},
+		{name: "connector_reset"},
+		{name: "saved_payment_initiation", since: "v3.0.0"},
+		{name: "saved_payment_initiation_adjustment", since: "v3.0.0"},
+		{name: "saved_payment_initiation_related_payment", since: "v3.0.0"},
+	},
+	"orchestration": {
+		{name: "started_workflow"},
+		{name: "started_workflow_stage"},
+		{name: "succeeded_workflow"},
+		{name: "succeeded_workflow_stage"},
+		{name: "failed_workflow"},
+		{name: "failed_workflow_stage"},
+	},
+}
+
+// availableEventTypes returns the event types which can be sent by the module at the given version.
+// Versions which are not semantic versions, such as `develop`, publish every event of the catalog.
+func availableEventTypes(module, version string) []string {
+	eventTypes := []string{}
+	for _, eventType := range webhookEventTypes[module] {
+		if eventType.since != "" && semver.IsValid(version) && semver.Compare(version, eventType.since) < 0 {
+			continue
+		}
+		eventTypes = append(eventTypes, module+"."+eventType.name)
+	}
+	return eventTypes
+}
+
+// checkEventType validates an event type against the catalog and the modules installed on the stack.
+// It returns a warning message, along with whether the event type is missing from the catalog
+// or only belongs to a module which is not installed.
+func checkEventType(eventType string, versions []shared.Version) (string, bool) {
+	eventType = strings.ToLower(eventType)
+	module, _, _ := strings.Cut(eventType, ".")
+	if _, ok := webhookEventTypes[module]; !ok {
+		all := []string{}
+		for module := range webhookEventTypes {
+			all = append(all, availableEventTypes(module, "")...)
+		}
+		return fmt.Sprintf("The event type '%s' is not published by any module of the stack.%s", eventType, didYouMean(eventType, all)), true
+	}
+
+	var version *shared.Version
+	for i := range versions {
+		if versions[i].Name == module {
+			version = &versions[i]
+			break
+		}
+	}
+	if version == nil {
+		return fmt.Sprintf("The module '%s' is not installed on the stack, no '%s' event will be sent until it is.", module, eventType), false
+	}
+
+	available := availableEventTypes(module, version.Version)
+	for _, candidate := range available {
+		if candidate == eventType {
+			return "", false
+		}
+	}
+	return fmt.Sprintf("The event type '%s' is not published by the module '%s' in version %s.%s", eventType, module, version.Version, didYouMean(eventType, available)), true
+}
+
+// didYouMean suggests the closest candidate to the value, if any is close enough.
+func didYouMean(value string, candidates []string) string {
+	candidates = slices.Sorted(slices.Values(candidates))
+	best, bestDistance := "", len(value)/2+1
+	for _, candidate := range candidates {
+		if distance := levenshtein(value, candidate); distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return fmt.Sprintf(" Did you mean '%s'?", best)
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
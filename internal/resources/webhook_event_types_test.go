@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhooksCheckEventType(t *testing.T) {
+	t.Parallel()
+
+	versions := []shared.Version{
+		{Name: "ledger", Version: "v2.2.0"},
+		{Name: "payments", Version: "v2.0.3"},
+		{Name: "orchestration", Version: "develop"},
+	}
+
+	type testCase struct {
+		eventType string
+		unknown   bool
+		message   string
+	}
+
+	for _, tc := range []testCase{
+		{eventType: "ledger.committed_transactions"},
+		{eventType: "Ledger.Committed_Transactions"},
+		{eventType: "orchestration.succeeded_workflow"},
+		{
+			eventType: "ledger.commited_transactions",
+			unknown:   true,
+			message:   "The event type 'ledger.commited_transactions' is not published by the module 'ledger' in version v2.2.0. Did you mean 'ledger.committed_transactions'?",
+		},
+		{
+			eventType: "payments.saved_payment_initiation",
+			unknown:   true,
+			message:   "The event type 'payments.saved_payment_initiation' is not published by the module 'payments' in version v2.0.3. Did you mean 'payments.saved_transfer_initiation'?",
+		},
+		{
+			eventType: "legder.saved_metadata",
+			unknown:   true,
+			message:   "The event type 'legder.saved_metadata' is not published by any module of the stack. Did you mean 'ledger.saved_metadata'?",
+		},
+		{
+			eventType: "unknown",
+			unknown:   true,
+			message:   "The event type 'unknown' is not published by any module of the stack.",
+		},
+	} {
+		t.Run(tc.eventType, func(t *testing.T) {
+			t.Parallel()
+
+			message, unknown := checkEventType(tc.eventType, versions)
+			require.Equal(t, tc.unknown, unknown)
+			require.Equal(t, tc.message, message)
+		})
+	}
+
+	message, unknown := checkEventType("ledger.saved_metadata", nil)
+	require.False(t, unknown)
+	require.Equal(t, "The module 'ledger' is not installed on the stack, no 'ledger.saved_metadata' event will be sent until it is.", message)
+}
+
+func TestWebhooksEventTypesCase(t *testing.T) {
+	t.Parallel()
+
+	model := WebhooksModel{
+		EventTypes: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("Ledger.Committed_Transactions"),
+		}),
+	}
+	model.setEventTypes([]string{"ledger.committed_transactions", "ledger.saved_metadata"})
+	require.ElementsMatch(t, []string{"Ledger.Committed_Transactions", "ledger.saved_metadata"}, model.eventTypes())
+
+	state := WebhooksModel{
+		EventTypes: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("ledger.saved_metadata"),
+			types.StringValue("ledger.committed_transactions"),
+		}),
+	}
+	require.False(t, model.configChanged(state))
+
+	state.EventTypes = types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("ledger.saved_metadata"),
+	})
+	require.True(t, model.configChanged(state))
+}
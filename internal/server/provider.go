@@ -12,6 +12,7 @@ import (
 	"github.com/formancehq/go-libs/v3/logging"
 	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
 	"github.com/formancehq/terraform-provider-stack/internal"
+	"github.com/formancehq/terraform-provider-stack/internal/datasources"
 	"github.com/formancehq/terraform-provider-stack/internal/resources"
 	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
 	"github.com/formancehq/terraform-provider-stack/pkg"
@@ -66,6 +67,7 @@ type FormanceStackProviderModel struct {
 	Uri            types.String `tfsdk:"uri"`
 
 	WaitModule types.String `tfsdk:"wait_module_duration"`
+	WaitTask   types.String `tfsdk:"wait_task_duration"`
 }
 
 type FormanceStackProvider struct {
@@ -120,6 +122,10 @@ var SchemaStack = schema.Schema{
 			Optional:    true,
 			Description: "The duration to wait for the module to be ready before proceeding.",
 		},
+		"wait_task_duration": schema.StringAttribute{
+			Optional:    true,
+			Description: "The duration to wait for asynchronous tasks, such as connector uninstallation or reset, to complete. Defaults to 5m.",
+		},
 	},
 }
 
@@ -219,6 +225,7 @@ func (p *FormanceStackProvider) Configure(ctx context.Context, req provider.Conf
 		StackSdkImpl:      p.stackSdkFactory(opts...),
 		CloudSDK:          cloudSDK,
 		WaitModuleTimeout: 2 * time.Minute,
+		WaitTaskTimeout:   5 * time.Minute,
 	}
 
 	if data.WaitModule.ValueString() != "" {
@@ -233,13 +240,47 @@ func (p *FormanceStackProvider) Configure(ctx context.Context, req provider.Conf
 		store.WaitModuleTimeout = duration
 	}
 
+	if data.WaitTask.ValueString() != "" {
+		duration, err := time.ParseDuration(data.WaitTask.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid wait_task_duration",
+				fmt.Sprintf("The provided wait_task_duration '%s' is not a valid duration: %s", data.WaitTask.ValueString(), err.Error()),
+			)
+			return
+		}
+		store.WaitTaskTimeout = duration
+	}
+
 	resp.ResourceData = store
 	resp.DataSourceData = store
 }
 
 // DataSources satisfies the provider.Provider interface for FormanceCloudProvider.
 func (p *FormanceStackProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	res := []func() datasource.DataSource{
+		datasources.NewPaymentsConnectors(),
+		datasources.NewPaymentsConnector(),
+		datasources.NewPaymentsConnectorSchedules(),
+		datasources.NewPaymentsConnectorScheduleInstances(),
+		datasources.NewPaymentsPoolBalances(),
+		datasources.NewReconciliationPolicies(),
+		datasources.NewReconciliations(),
+		datasources.NewReconciliation(),
+		datasources.NewWebhookConfigs(),
+		datasources.NewOrchestrationInstances(),
+		datasources.NewOrchestrationInstance(),
+		datasources.NewOrchestrationTriggerOccurrences(),
+		datasources.NewWallets(),
+		datasources.NewWalletSummary(),
+		datasources.NewAuthUsers(),
+		datasources.NewAuthUser(),
+		datasources.NewAuthClients(),
+		datasources.NewAuthClient(),
+	}
+	return collectionutils.Map(res, func(fn func() datasource.DataSource) func() datasource.DataSource {
+		return datasources.NewDataSourceTracer(p.tracer, p.logger, fn())
+	})
 }
 
 // Resources satisfies the provider.Provider interface for FormanceCloudProvider.
@@ -251,7 +292,14 @@ func (p *FormanceStackProvider) Resources(ctx context.Context) []func() resource
 		resources.NewPaymentsConnectors(),
 		resources.NewPaymentsPool(),
 		resources.NewReconciliationPolicy(),
+		resources.NewReconciliation(),
 		resources.NewLedgerSchema(),
+		resources.NewOrchestrationWorkflow(),
+		resources.NewOrchestrationTrigger(),
+		resources.NewWallet(),
+		resources.NewWalletBalance(),
+		resources.NewAuthClient(),
+		resources.NewAuthClientSecret(),
 	}
 	return collectionutils.Map(res, func(fn func() resource.Resource) func() resource.Resource {
 		return resources.NewResourceTracer(p.tracer, p.logger, fn())
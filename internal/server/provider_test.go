@@ -20,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/require"
 )
@@ -49,6 +51,28 @@ func TestProviderMetadata(t *testing.T) {
 	require.Equal(t, res.Version, "develop")
 }
 
+func TestProviderSchemas(t *testing.T) {
+	t.Parallel()
+	p := server.NewStackProvider(
+		noop.NewTracerProvider(),
+		logging.Testing(),
+		"https://app.formance.cloud/api",
+		"client_id",
+		"client_secret",
+		http.DefaultTransport,
+		sdk.NewCloudSDK(),
+		cloudpkg.NewTokenProvider,
+		func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+			return pkg.NewTokenProvider(transport, creds, tokenProvider, stack)
+		},
+		sdk.NewStackSdk(),
+	)()
+
+	res, err := providerserver.NewProtocol6(p)().GetProviderSchema(logging.TestingContext(), &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Diagnostics)
+}
+
 func getSchemaTypes(schema schema.Schema) map[string]tftypes.Type {
 	attributeTypes := make(map[string]tftypes.Type)
 	schemaAt := schema.Attributes
@@ -140,6 +164,7 @@ func TestProviderConfigure(t *testing.T) {
 						"organization_id":      tftypes.NewValue(tftypes.String, organizationId),
 						"uri":                  tftypes.NewValue(tftypes.String, stackUri),
 						"wait_module_duration": tftypes.NewValue(tftypes.String, nil),
+						"wait_task_duration":   tftypes.NewValue(tftypes.String, nil),
 						"cloud": tftypes.NewValue(schemaType["cloud"], map[string]tftypes.Value{
 							"client_id":     tftypes.NewValue(tftypes.String, tc.ClientId),
 							"client_secret": tftypes.NewValue(tftypes.String, tc.ClientSecret),
@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"context"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+)
+
+//go:generate mockgen -typed -destination=auth_generated.go -package=sdk . AuthSdkImpl
+type AuthSdkImpl interface {
+	ListClients(ctx context.Context, opts ...operations.Option) (*operations.ListClientsResponse, error)
+	CreateClient(ctx context.Context, request *shared.ClientOptions, opts ...operations.Option) (*operations.CreateClientResponse, error)
+	ReadClient(ctx context.Context, request operations.ReadClientRequest, opts ...operations.Option) (*operations.ReadClientResponse, error)
+	UpdateClient(ctx context.Context, request operations.UpdateClientRequest, opts ...operations.Option) (*operations.UpdateClientResponse, error)
+	DeleteClient(ctx context.Context, request operations.DeleteClientRequest, opts ...operations.Option) (*operations.DeleteClientResponse, error)
+	CreateSecret(ctx context.Context, request operations.CreateSecretRequest, opts ...operations.Option) (*operations.CreateSecretResponse, error)
+	DeleteSecret(ctx context.Context, request operations.DeleteSecretRequest, opts ...operations.Option) (*operations.DeleteSecretResponse, error)
+	ListUsers(ctx context.Context, opts ...operations.Option) (*operations.ListUsersResponse, error)
+	ReadUser(ctx context.Context, request operations.ReadUserRequest, opts ...operations.Option) (*operations.ReadUserResponse, error)
+	GetOIDCWellKnowns(ctx context.Context, opts ...operations.Option) (*operations.GetOIDCWellKnownsResponse, error)
+}
+
+var _ AuthSdkImpl = &defaultAuthSdk{}
+
+type defaultAuthSdk struct {
+	*formance.Auth
+}
+
+func (s *defaultAuthSdk) ListClients(ctx context.Context, opts ...operations.Option) (*operations.ListClientsResponse, error) {
+	return s.V1.ListClients(ctx, opts...)
+}
+
+func (s *defaultAuthSdk) CreateClient(ctx context.Context, request *shared.ClientOptions, opts ...operations.Option) (*operations.CreateClientResponse, error) {
+	return s.V1.CreateClient(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) ReadClient(ctx context.Context, request operations.ReadClientRequest, opts ...operations.Option) (*operations.ReadClientResponse, error) {
+	return s.V1.ReadClient(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) UpdateClient(ctx context.Context, request operations.UpdateClientRequest, opts ...operations.Option) (*operations.UpdateClientResponse, error) {
+	return s.V1.UpdateClient(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) DeleteClient(ctx context.Context, request operations.DeleteClientRequest, opts ...operations.Option) (*operations.DeleteClientResponse, error) {
+	return s.V1.DeleteClient(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) CreateSecret(ctx context.Context, request operations.CreateSecretRequest, opts ...operations.Option) (*operations.CreateSecretResponse, error) {
+	return s.V1.CreateSecret(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) DeleteSecret(ctx context.Context, request operations.DeleteSecretRequest, opts ...operations.Option) (*operations.DeleteSecretResponse, error) {
+	return s.V1.DeleteSecret(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) ListUsers(ctx context.Context, opts ...operations.Option) (*operations.ListUsersResponse, error) {
+	return s.V1.ListUsers(ctx, opts...)
+}
+
+func (s *defaultAuthSdk) ReadUser(ctx context.Context, request operations.ReadUserRequest, opts ...operations.Option) (*operations.ReadUserResponse, error) {
+	return s.V1.ReadUser(ctx, request, opts...)
+}
+
+func (s *defaultAuthSdk) GetOIDCWellKnowns(ctx context.Context, opts ...operations.Option) (*operations.GetOIDCWellKnownsResponse, error) {
+	return s.V1.GetOIDCWellKnowns(ctx, opts...)
+}
+
+func newAuthSdk(auth *formance.Auth) AuthSdkImpl {
+	return &defaultAuthSdk{
+		Auth: auth,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/sdkerrors"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -16,6 +17,15 @@ type Error struct {
 	ErrorMessage string `json:"errorMessage"`
 }
 
+// IsNotFoundError reports whether err is an SDK error caused by a 404 response.
+func IsNotFoundError(err error) bool {
+	tmp := &sdkerrors.SDKError{}
+	if errors.As(err, &tmp) {
+		return tmp.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
 func HandleStackError(ctx context.Context, err error, diag *diag.Diagnostics) {
 	sharedError := &Error{
 		ErrorCode:    "INTERNAL",
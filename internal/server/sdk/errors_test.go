@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/sdkerrors"
@@ -79,3 +80,36 @@ func TestHandleSDKError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNotFoundError(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "SDKError not found",
+			err:      &sdkerrors.SDKError{StatusCode: 404},
+			expected: true,
+		},
+		{
+			name:     "SDKError wrapped not found",
+			err:      fmt.Errorf("wrapped: %w", &sdkerrors.SDKError{StatusCode: 404}),
+			expected: true,
+		},
+		{
+			name:     "SDKError bad request",
+			err:      &sdkerrors.SDKError{StatusCode: 400},
+			expected: false,
+		},
+		{
+			name:     "generic error",
+			err:      errors.New("some random error"),
+			expected: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, IsNotFoundError(tt.err))
+		})
+	}
+}
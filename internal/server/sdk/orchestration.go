@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	"context"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+)
+
+//go:generate mockgen -typed -destination=orchestration_generated.go -package=sdk . OrchestrationSdkImpl
+type OrchestrationSdkImpl interface {
+	CreateWorkflow(ctx context.Context, request *shared.V2WorkflowConfig, opts ...operations.Option) (*operations.V2CreateWorkflowResponse, error)
+	GetWorkflow(ctx context.Context, request operations.V2GetWorkflowRequest, opts ...operations.Option) (*operations.V2GetWorkflowResponse, error)
+	DeleteWorkflow(ctx context.Context, request operations.V2DeleteWorkflowRequest, opts ...operations.Option) (*operations.V2DeleteWorkflowResponse, error)
+	CreateTrigger(ctx context.Context, request *shared.V2TriggerData, opts ...operations.Option) (*operations.V2CreateTriggerResponse, error)
+	ReadTrigger(ctx context.Context, request operations.V2ReadTriggerRequest, opts ...operations.Option) (*operations.V2ReadTriggerResponse, error)
+	DeleteTrigger(ctx context.Context, request operations.V2DeleteTriggerRequest, opts ...operations.Option) (*operations.V2DeleteTriggerResponse, error)
+	TestTrigger(ctx context.Context, request operations.TestTriggerRequest, opts ...operations.Option) (*operations.TestTriggerResponse, error)
+	ListTriggersOccurrences(ctx context.Context, request operations.V2ListTriggersOccurrencesRequest, opts ...operations.Option) (*operations.V2ListTriggersOccurrencesResponse, error)
+	ListInstances(ctx context.Context, request operations.V2ListInstancesRequest, opts ...operations.Option) (*operations.V2ListInstancesResponse, error)
+	GetInstance(ctx context.Context, request operations.V2GetInstanceRequest, opts ...operations.Option) (*operations.V2GetInstanceResponse, error)
+	GetInstanceHistory(ctx context.Context, request operations.V2GetInstanceHistoryRequest, opts ...operations.Option) (*operations.V2GetInstanceHistoryResponse, error)
+}
+
+var _ OrchestrationSdkImpl = &defaultOrchestrationSdk{}
+
+type defaultOrchestrationSdk struct {
+	*formance.Orchestration
+}
+
+func (s *defaultOrchestrationSdk) CreateWorkflow(ctx context.Context, request *shared.V2WorkflowConfig, opts ...operations.Option) (*operations.V2CreateWorkflowResponse, error) {
+	return s.V2.CreateWorkflow(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) GetWorkflow(ctx context.Context, request operations.V2GetWorkflowRequest, opts ...operations.Option) (*operations.V2GetWorkflowResponse, error) {
+	return s.V2.GetWorkflow(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) DeleteWorkflow(ctx context.Context, request operations.V2DeleteWorkflowRequest, opts ...operations.Option) (*operations.V2DeleteWorkflowResponse, error) {
+	return s.V2.DeleteWorkflow(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) CreateTrigger(ctx context.Context, request *shared.V2TriggerData, opts ...operations.Option) (*operations.V2CreateTriggerResponse, error) {
+	return s.V2.CreateTrigger(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) ReadTrigger(ctx context.Context, request operations.V2ReadTriggerRequest, opts ...operations.Option) (*operations.V2ReadTriggerResponse, error) {
+	return s.V2.ReadTrigger(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) DeleteTrigger(ctx context.Context, request operations.V2DeleteTriggerRequest, opts ...operations.Option) (*operations.V2DeleteTriggerResponse, error) {
+	return s.V2.DeleteTrigger(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) TestTrigger(ctx context.Context, request operations.TestTriggerRequest, opts ...operations.Option) (*operations.TestTriggerResponse, error) {
+	return s.V2.TestTrigger(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) ListTriggersOccurrences(ctx context.Context, request operations.V2ListTriggersOccurrencesRequest, opts ...operations.Option) (*operations.V2ListTriggersOccurrencesResponse, error) {
+	return s.V2.ListTriggersOccurrences(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) ListInstances(ctx context.Context, request operations.V2ListInstancesRequest, opts ...operations.Option) (*operations.V2ListInstancesResponse, error) {
+	return s.V2.ListInstances(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) GetInstance(ctx context.Context, request operations.V2GetInstanceRequest, opts ...operations.Option) (*operations.V2GetInstanceResponse, error) {
+	return s.V2.GetInstance(ctx, request, opts...)
+}
+
+func (s *defaultOrchestrationSdk) GetInstanceHistory(ctx context.Context, request operations.V2GetInstanceHistoryRequest, opts ...operations.Option) (*operations.V2GetInstanceHistoryResponse, error) {
+	return s.V2.GetInstanceHistory(ctx, request, opts...)
+}
+
+func newOrchestrationSdk(orchestration *formance.Orchestration) OrchestrationSdkImpl {
+	return &defaultOrchestrationSdk{
+		Orchestration: orchestration,
+	}
+}
@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+)
+
+// Cursor is a page of items returned by a cursor paginated endpoint.
+type Cursor[T any] struct {
+	Data    []T
+	HasMore bool
+	Next    *string
+}
+
+// PageFetcher fetches the page designated by the cursor. The first page is fetched with a nil cursor.
+type PageFetcher[T any] func(ctx context.Context, cursor *string) (Cursor[T], error)
+
+// Paginate follows the cursors returned by fetch and collects the items of every page.
+func Paginate[T any](ctx context.Context, fetch PageFetcher[T]) ([]T, error) {
+	return PaginateLimit(ctx, 0, fetch)
+}
+
+// PaginateLimit follows the cursors returned by fetch until limit items are collected.
+// A limit of 0 collects the items of every page.
+func PaginateLimit[T any](ctx context.Context, limit int, fetch PageFetcher[T]) ([]T, error) {
+	items := []T{}
+	var cursor *string
+	for {
+		page, err := fetch(ctx, cursor)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Data...)
+
+		if limit > 0 && len(items) >= limit {
+			return items[:limit], nil
+		}
+		if !page.HasMore || page.Next == nil || *page.Next == "" {
+			return items, nil
+		}
+		if cursor != nil && *cursor == *page.Next {
+			return nil, fmt.Errorf("pagination did not progress, cursor %q returned twice", *cursor)
+		}
+		cursor = page.Next
+	}
+}
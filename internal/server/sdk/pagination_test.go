@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/formancehq/go-libs/v3/pointer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]Cursor[int]{
+		"":      {Data: []int{1, 2}, HasMore: true, Next: pointer.For("page2")},
+		"page2": {Data: []int{3}, HasMore: true, Next: pointer.For("page3")},
+		"page3": {Data: []int{4, 5}, HasMore: false},
+	}
+
+	var cursors []string
+	items, err := Paginate(context.Background(), func(ctx context.Context, cursor *string) (Cursor[int], error) {
+		key := ""
+		if cursor != nil {
+			key = *cursor
+		}
+		cursors = append(cursors, key)
+		return pages[key], nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5}, items)
+	require.Equal(t, []string{"", "page2", "page3"}, cursors)
+}
+
+func TestPaginateEmpty(t *testing.T) {
+	t.Parallel()
+
+	items, err := Paginate(context.Background(), func(ctx context.Context, cursor *string) (Cursor[string], error) {
+		return Cursor[string]{}, nil
+	})
+	require.NoError(t, err)
+	require.Empty(t, items)
+	require.NotNil(t, items)
+}
+
+func TestPaginateError(t *testing.T) {
+	t.Parallel()
+
+	expected := errors.New("boom")
+	_, err := Paginate(context.Background(), func(ctx context.Context, cursor *string) (Cursor[int], error) {
+		if cursor == nil {
+			return Cursor[int]{Data: []int{1}, HasMore: true, Next: pointer.For("next")}, nil
+		}
+		return Cursor[int]{}, expected
+	})
+	require.ErrorIs(t, err, expected)
+}
+
+func TestPaginateStuckCursor(t *testing.T) {
+	t.Parallel()
+
+	_, err := Paginate(context.Background(), func(ctx context.Context, cursor *string) (Cursor[int], error) {
+		return Cursor[int]{Data: []int{1}, HasMore: true, Next: pointer.For("same")}, nil
+	})
+	require.Error(t, err)
+}
+
+func TestPaginateLimit(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]Cursor[int]{
+		"":      {Data: []int{1, 2}, HasMore: true, Next: pointer.For("page2")},
+		"page2": {Data: []int{3, 4}, HasMore: true, Next: pointer.For("page3")},
+		"page3": {Data: []int{5}, HasMore: false},
+	}
+
+	var cursors []string
+	items, err := PaginateLimit(context.Background(), 3, func(ctx context.Context, cursor *string) (Cursor[int], error) {
+		key := ""
+		if cursor != nil {
+			key = *cursor
+		}
+		cursors = append(cursors, key)
+		return pages[key], nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, items)
+	require.Equal(t, []string{"", "page2"}, cursors)
+}
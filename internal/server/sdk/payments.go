@@ -14,14 +14,24 @@ type PaymentsSdkImpl interface {
 	GetPool(ctx context.Context, request operations.V3GetPoolRequest) (*operations.V3GetPoolResponse, error)
 	UpdatePool(ctx context.Context, request operations.V3UpdatePoolQueryRequest) (*operations.V3UpdatePoolQueryResponse, error)
 	DeletePool(ctx context.Context, request operations.V3DeletePoolRequest) (*operations.V3DeletePoolResponse, error)
+	GetPoolBalances(ctx context.Context, request operations.V3GetPoolBalancesRequest) (*operations.V3GetPoolBalancesResponse, error)
+	GetPoolBalancesLatest(ctx context.Context, request operations.V3GetPoolBalancesLatestRequest) (*operations.V3GetPoolBalancesLatestResponse, error)
 
 	AddAccountToPool(ctx context.Context, request operations.V3AddAccountToPoolRequest) (*operations.V3AddAccountToPoolResponse, error)
 	RemoveAccountFromPool(ctx context.Context, request operations.V3RemoveAccountFromPoolRequest) (*operations.V3RemoveAccountFromPoolResponse, error)
+	ListAccounts(ctx context.Context, request operations.V3ListAccountsRequest) (*operations.V3ListAccountsResponse, error)
+	GetAccount(ctx context.Context, request operations.V3GetAccountRequest) (*operations.V3GetAccountResponse, error)
 
 	CreateConnector(ctx context.Context, request operations.V3InstallConnectorRequest) (*operations.V3InstallConnectorResponse, error)
 	GetConnector(ctx context.Context, request operations.V3GetConnectorConfigRequest) (*operations.V3GetConnectorConfigResponse, error)
 	DeleteConnector(ctx context.Context, request operations.V3UninstallConnectorRequest) (*operations.V3UninstallConnectorResponse, error)
 	UpdateConnector(ctx context.Context, request operations.V3UpdateConnectorConfigRequest) (*operations.V3UpdateConnectorConfigResponse, error)
+	ResetConnector(ctx context.Context, request operations.V3ResetConnectorRequest) (*operations.V3ResetConnectorResponse, error)
+	ListConnectors(ctx context.Context, request operations.V3ListConnectorsRequest) (*operations.V3ListConnectorsResponse, error)
+	ListConnectorSchedules(ctx context.Context, request operations.V3ListConnectorSchedulesRequest) (*operations.V3ListConnectorSchedulesResponse, error)
+	ListConnectorScheduleInstances(ctx context.Context, request operations.V3ListConnectorScheduleInstancesRequest) (*operations.V3ListConnectorScheduleInstancesResponse, error)
+
+	GetTask(ctx context.Context, request operations.V3GetTaskRequest) (*operations.V3GetTaskResponse, error)
 }
 
 var _ PaymentsSdkImpl = &defaultPaymentsSdk{}
@@ -46,6 +56,14 @@ func (s *defaultPaymentsSdk) DeletePool(ctx context.Context, request operations.
 	return s.V3.DeletePool(ctx, request)
 }
 
+func (s *defaultPaymentsSdk) GetPoolBalances(ctx context.Context, request operations.V3GetPoolBalancesRequest) (*operations.V3GetPoolBalancesResponse, error) {
+	return s.V3.GetPoolBalances(ctx, request)
+}
+
+func (s *defaultPaymentsSdk) GetPoolBalancesLatest(ctx context.Context, request operations.V3GetPoolBalancesLatestRequest) (*operations.V3GetPoolBalancesLatestResponse, error) {
+	return s.V3.GetPoolBalancesLatest(ctx, request)
+}
+
 func (s *defaultPaymentsSdk) AddAccountToPool(ctx context.Context, request operations.V3AddAccountToPoolRequest) (*operations.V3AddAccountToPoolResponse, error) {
 	return s.V3.AddAccountToPool(ctx, request)
 }
@@ -53,6 +71,14 @@ func (s *defaultPaymentsSdk) RemoveAccountFromPool(ctx context.Context, request
 	return s.V3.RemoveAccountFromPool(ctx, request)
 }
 
+func (s *defaultPaymentsSdk) ListAccounts(ctx context.Context, request operations.V3ListAccountsRequest) (*operations.V3ListAccountsResponse, error) {
+	return s.V3.ListAccounts(ctx, request)
+}
+
+func (s *defaultPaymentsSdk) GetAccount(ctx context.Context, request operations.V3GetAccountRequest) (*operations.V3GetAccountResponse, error) {
+	return s.V3.GetAccount(ctx, request)
+}
+
 func (s *defaultPaymentsSdk) CreateConnector(ctx context.Context, request operations.V3InstallConnectorRequest) (*operations.V3InstallConnectorResponse, error) {
 	return s.V3.InstallConnector(ctx, request)
 }
@@ -69,6 +95,26 @@ func (s *defaultPaymentsSdk) UpdateConnector(ctx context.Context, request operat
 	return s.V3.V3UpdateConnectorConfig(ctx, request)
 }
 
+func (s *defaultPaymentsSdk) ResetConnector(ctx context.Context, request operations.V3ResetConnectorRequest) (*operations.V3ResetConnectorResponse, error) {
+	return s.V3.ResetConnector(ctx, request)
+}
+
+func (s *defaultPaymentsSdk) ListConnectors(ctx context.Context, request operations.V3ListConnectorsRequest) (*operations.V3ListConnectorsResponse, error) {
+	return s.V3.ListConnectors(ctx, request)
+}
+
+func (s *defaultPaymentsSdk) ListConnectorSchedules(ctx context.Context, request operations.V3ListConnectorSchedulesRequest) (*operations.V3ListConnectorSchedulesResponse, error) {
+	return s.V3.ListConnectorSchedules(ctx, request)
+}
+
+func (s *defaultPaymentsSdk) ListConnectorScheduleInstances(ctx context.Context, request operations.V3ListConnectorScheduleInstancesRequest) (*operations.V3ListConnectorScheduleInstancesResponse, error) {
+	return s.V3.ListConnectorScheduleInstances(ctx, request)
+}
+
+func (s *defaultPaymentsSdk) GetTask(ctx context.Context, request operations.V3GetTaskRequest) (*operations.V3GetTaskResponse, error) {
+	return s.V3.GetTask(ctx, request)
+}
+
 func newPaymentsSdk(payments *formance.Payments) PaymentsSdkImpl {
 	return &defaultPaymentsSdk{
 		Payments: payments,
@@ -237,6 +237,45 @@ func (c *MockPaymentsSdkImplDeletePoolCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetAccount mocks base method.
+func (m *MockPaymentsSdkImpl) GetAccount(ctx context.Context, request operations.V3GetAccountRequest) (*operations.V3GetAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, request)
+	ret0, _ := ret[0].(*operations.V3GetAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockPaymentsSdkImplMockRecorder) GetAccount(ctx, request any) *MockPaymentsSdkImplGetAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).GetAccount), ctx, request)
+	return &MockPaymentsSdkImplGetAccountCall{Call: call}
+}
+
+// MockPaymentsSdkImplGetAccountCall wrap *gomock.Call
+type MockPaymentsSdkImplGetAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplGetAccountCall) Return(arg0 *operations.V3GetAccountResponse, arg1 error) *MockPaymentsSdkImplGetAccountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplGetAccountCall) Do(f func(context.Context, operations.V3GetAccountRequest) (*operations.V3GetAccountResponse, error)) *MockPaymentsSdkImplGetAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplGetAccountCall) DoAndReturn(f func(context.Context, operations.V3GetAccountRequest) (*operations.V3GetAccountResponse, error)) *MockPaymentsSdkImplGetAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetConnector mocks base method.
 func (m *MockPaymentsSdkImpl) GetConnector(ctx context.Context, request operations.V3GetConnectorConfigRequest) (*operations.V3GetConnectorConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -315,6 +354,279 @@ func (c *MockPaymentsSdkImplGetPoolCall) DoAndReturn(f func(context.Context, ope
 	return c
 }
 
+// GetPoolBalances mocks base method.
+func (m *MockPaymentsSdkImpl) GetPoolBalances(ctx context.Context, request operations.V3GetPoolBalancesRequest) (*operations.V3GetPoolBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolBalances", ctx, request)
+	ret0, _ := ret[0].(*operations.V3GetPoolBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolBalances indicates an expected call of GetPoolBalances.
+func (mr *MockPaymentsSdkImplMockRecorder) GetPoolBalances(ctx, request any) *MockPaymentsSdkImplGetPoolBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolBalances", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).GetPoolBalances), ctx, request)
+	return &MockPaymentsSdkImplGetPoolBalancesCall{Call: call}
+}
+
+// MockPaymentsSdkImplGetPoolBalancesCall wrap *gomock.Call
+type MockPaymentsSdkImplGetPoolBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplGetPoolBalancesCall) Return(arg0 *operations.V3GetPoolBalancesResponse, arg1 error) *MockPaymentsSdkImplGetPoolBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplGetPoolBalancesCall) Do(f func(context.Context, operations.V3GetPoolBalancesRequest) (*operations.V3GetPoolBalancesResponse, error)) *MockPaymentsSdkImplGetPoolBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplGetPoolBalancesCall) DoAndReturn(f func(context.Context, operations.V3GetPoolBalancesRequest) (*operations.V3GetPoolBalancesResponse, error)) *MockPaymentsSdkImplGetPoolBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPoolBalancesLatest mocks base method.
+func (m *MockPaymentsSdkImpl) GetPoolBalancesLatest(ctx context.Context, request operations.V3GetPoolBalancesLatestRequest) (*operations.V3GetPoolBalancesLatestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolBalancesLatest", ctx, request)
+	ret0, _ := ret[0].(*operations.V3GetPoolBalancesLatestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolBalancesLatest indicates an expected call of GetPoolBalancesLatest.
+func (mr *MockPaymentsSdkImplMockRecorder) GetPoolBalancesLatest(ctx, request any) *MockPaymentsSdkImplGetPoolBalancesLatestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolBalancesLatest", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).GetPoolBalancesLatest), ctx, request)
+	return &MockPaymentsSdkImplGetPoolBalancesLatestCall{Call: call}
+}
+
+// MockPaymentsSdkImplGetPoolBalancesLatestCall wrap *gomock.Call
+type MockPaymentsSdkImplGetPoolBalancesLatestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplGetPoolBalancesLatestCall) Return(arg0 *operations.V3GetPoolBalancesLatestResponse, arg1 error) *MockPaymentsSdkImplGetPoolBalancesLatestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplGetPoolBalancesLatestCall) Do(f func(context.Context, operations.V3GetPoolBalancesLatestRequest) (*operations.V3GetPoolBalancesLatestResponse, error)) *MockPaymentsSdkImplGetPoolBalancesLatestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplGetPoolBalancesLatestCall) DoAndReturn(f func(context.Context, operations.V3GetPoolBalancesLatestRequest) (*operations.V3GetPoolBalancesLatestResponse, error)) *MockPaymentsSdkImplGetPoolBalancesLatestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTask mocks base method.
+func (m *MockPaymentsSdkImpl) GetTask(ctx context.Context, request operations.V3GetTaskRequest) (*operations.V3GetTaskResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTask", ctx, request)
+	ret0, _ := ret[0].(*operations.V3GetTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTask indicates an expected call of GetTask.
+func (mr *MockPaymentsSdkImplMockRecorder) GetTask(ctx, request any) *MockPaymentsSdkImplGetTaskCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTask", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).GetTask), ctx, request)
+	return &MockPaymentsSdkImplGetTaskCall{Call: call}
+}
+
+// MockPaymentsSdkImplGetTaskCall wrap *gomock.Call
+type MockPaymentsSdkImplGetTaskCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplGetTaskCall) Return(arg0 *operations.V3GetTaskResponse, arg1 error) *MockPaymentsSdkImplGetTaskCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplGetTaskCall) Do(f func(context.Context, operations.V3GetTaskRequest) (*operations.V3GetTaskResponse, error)) *MockPaymentsSdkImplGetTaskCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplGetTaskCall) DoAndReturn(f func(context.Context, operations.V3GetTaskRequest) (*operations.V3GetTaskResponse, error)) *MockPaymentsSdkImplGetTaskCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAccounts mocks base method.
+func (m *MockPaymentsSdkImpl) ListAccounts(ctx context.Context, request operations.V3ListAccountsRequest) (*operations.V3ListAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccounts", ctx, request)
+	ret0, _ := ret[0].(*operations.V3ListAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccounts indicates an expected call of ListAccounts.
+func (mr *MockPaymentsSdkImplMockRecorder) ListAccounts(ctx, request any) *MockPaymentsSdkImplListAccountsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).ListAccounts), ctx, request)
+	return &MockPaymentsSdkImplListAccountsCall{Call: call}
+}
+
+// MockPaymentsSdkImplListAccountsCall wrap *gomock.Call
+type MockPaymentsSdkImplListAccountsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplListAccountsCall) Return(arg0 *operations.V3ListAccountsResponse, arg1 error) *MockPaymentsSdkImplListAccountsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplListAccountsCall) Do(f func(context.Context, operations.V3ListAccountsRequest) (*operations.V3ListAccountsResponse, error)) *MockPaymentsSdkImplListAccountsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplListAccountsCall) DoAndReturn(f func(context.Context, operations.V3ListAccountsRequest) (*operations.V3ListAccountsResponse, error)) *MockPaymentsSdkImplListAccountsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListConnectorScheduleInstances mocks base method.
+func (m *MockPaymentsSdkImpl) ListConnectorScheduleInstances(ctx context.Context, request operations.V3ListConnectorScheduleInstancesRequest) (*operations.V3ListConnectorScheduleInstancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectorScheduleInstances", ctx, request)
+	ret0, _ := ret[0].(*operations.V3ListConnectorScheduleInstancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectorScheduleInstances indicates an expected call of ListConnectorScheduleInstances.
+func (mr *MockPaymentsSdkImplMockRecorder) ListConnectorScheduleInstances(ctx, request any) *MockPaymentsSdkImplListConnectorScheduleInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectorScheduleInstances", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).ListConnectorScheduleInstances), ctx, request)
+	return &MockPaymentsSdkImplListConnectorScheduleInstancesCall{Call: call}
+}
+
+// MockPaymentsSdkImplListConnectorScheduleInstancesCall wrap *gomock.Call
+type MockPaymentsSdkImplListConnectorScheduleInstancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplListConnectorScheduleInstancesCall) Return(arg0 *operations.V3ListConnectorScheduleInstancesResponse, arg1 error) *MockPaymentsSdkImplListConnectorScheduleInstancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplListConnectorScheduleInstancesCall) Do(f func(context.Context, operations.V3ListConnectorScheduleInstancesRequest) (*operations.V3ListConnectorScheduleInstancesResponse, error)) *MockPaymentsSdkImplListConnectorScheduleInstancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplListConnectorScheduleInstancesCall) DoAndReturn(f func(context.Context, operations.V3ListConnectorScheduleInstancesRequest) (*operations.V3ListConnectorScheduleInstancesResponse, error)) *MockPaymentsSdkImplListConnectorScheduleInstancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListConnectorSchedules mocks base method.
+func (m *MockPaymentsSdkImpl) ListConnectorSchedules(ctx context.Context, request operations.V3ListConnectorSchedulesRequest) (*operations.V3ListConnectorSchedulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectorSchedules", ctx, request)
+	ret0, _ := ret[0].(*operations.V3ListConnectorSchedulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectorSchedules indicates an expected call of ListConnectorSchedules.
+func (mr *MockPaymentsSdkImplMockRecorder) ListConnectorSchedules(ctx, request any) *MockPaymentsSdkImplListConnectorSchedulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectorSchedules", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).ListConnectorSchedules), ctx, request)
+	return &MockPaymentsSdkImplListConnectorSchedulesCall{Call: call}
+}
+
+// MockPaymentsSdkImplListConnectorSchedulesCall wrap *gomock.Call
+type MockPaymentsSdkImplListConnectorSchedulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplListConnectorSchedulesCall) Return(arg0 *operations.V3ListConnectorSchedulesResponse, arg1 error) *MockPaymentsSdkImplListConnectorSchedulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplListConnectorSchedulesCall) Do(f func(context.Context, operations.V3ListConnectorSchedulesRequest) (*operations.V3ListConnectorSchedulesResponse, error)) *MockPaymentsSdkImplListConnectorSchedulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplListConnectorSchedulesCall) DoAndReturn(f func(context.Context, operations.V3ListConnectorSchedulesRequest) (*operations.V3ListConnectorSchedulesResponse, error)) *MockPaymentsSdkImplListConnectorSchedulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListConnectors mocks base method.
+func (m *MockPaymentsSdkImpl) ListConnectors(ctx context.Context, request operations.V3ListConnectorsRequest) (*operations.V3ListConnectorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConnectors", ctx, request)
+	ret0, _ := ret[0].(*operations.V3ListConnectorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConnectors indicates an expected call of ListConnectors.
+func (mr *MockPaymentsSdkImplMockRecorder) ListConnectors(ctx, request any) *MockPaymentsSdkImplListConnectorsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConnectors", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).ListConnectors), ctx, request)
+	return &MockPaymentsSdkImplListConnectorsCall{Call: call}
+}
+
+// MockPaymentsSdkImplListConnectorsCall wrap *gomock.Call
+type MockPaymentsSdkImplListConnectorsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplListConnectorsCall) Return(arg0 *operations.V3ListConnectorsResponse, arg1 error) *MockPaymentsSdkImplListConnectorsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplListConnectorsCall) Do(f func(context.Context, operations.V3ListConnectorsRequest) (*operations.V3ListConnectorsResponse, error)) *MockPaymentsSdkImplListConnectorsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplListConnectorsCall) DoAndReturn(f func(context.Context, operations.V3ListConnectorsRequest) (*operations.V3ListConnectorsResponse, error)) *MockPaymentsSdkImplListConnectorsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveAccountFromPool mocks base method.
 func (m *MockPaymentsSdkImpl) RemoveAccountFromPool(ctx context.Context, request operations.V3RemoveAccountFromPoolRequest) (*operations.V3RemoveAccountFromPoolResponse, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +666,45 @@ func (c *MockPaymentsSdkImplRemoveAccountFromPoolCall) DoAndReturn(f func(contex
 	return c
 }
 
+// ResetConnector mocks base method.
+func (m *MockPaymentsSdkImpl) ResetConnector(ctx context.Context, request operations.V3ResetConnectorRequest) (*operations.V3ResetConnectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetConnector", ctx, request)
+	ret0, _ := ret[0].(*operations.V3ResetConnectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConnector indicates an expected call of ResetConnector.
+func (mr *MockPaymentsSdkImplMockRecorder) ResetConnector(ctx, request any) *MockPaymentsSdkImplResetConnectorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConnector", reflect.TypeOf((*MockPaymentsSdkImpl)(nil).ResetConnector), ctx, request)
+	return &MockPaymentsSdkImplResetConnectorCall{Call: call}
+}
+
+// MockPaymentsSdkImplResetConnectorCall wrap *gomock.Call
+type MockPaymentsSdkImplResetConnectorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPaymentsSdkImplResetConnectorCall) Return(arg0 *operations.V3ResetConnectorResponse, arg1 error) *MockPaymentsSdkImplResetConnectorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPaymentsSdkImplResetConnectorCall) Do(f func(context.Context, operations.V3ResetConnectorRequest) (*operations.V3ResetConnectorResponse, error)) *MockPaymentsSdkImplResetConnectorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPaymentsSdkImplResetConnectorCall) DoAndReturn(f func(context.Context, operations.V3ResetConnectorRequest) (*operations.V3ResetConnectorResponse, error)) *MockPaymentsSdkImplResetConnectorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateConnector mocks base method.
 func (m *MockPaymentsSdkImpl) UpdateConnector(ctx context.Context, request operations.V3UpdateConnectorConfigRequest) (*operations.V3UpdateConnectorConfigResponse, error) {
 	m.ctrl.T.Helper()
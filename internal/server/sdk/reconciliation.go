@@ -13,6 +13,10 @@ type ReconciliationSdkImpl interface {
 	CreatePolicy(ctx context.Context, request shared.PolicyRequest, opts ...operations.Option) (*operations.CreatePolicyResponse, error)
 	GetPolicy(ctx context.Context, request operations.GetPolicyRequest, opts ...operations.Option) (*operations.GetPolicyResponse, error)
 	DeletePolicy(ctx context.Context, request operations.DeletePolicyRequest, opts ...operations.Option) (*operations.DeletePolicyResponse, error)
+	ListPolicies(ctx context.Context, request operations.ListPoliciesRequest, opts ...operations.Option) (*operations.ListPoliciesResponse, error)
+	Reconcile(ctx context.Context, request operations.ReconcileRequest, opts ...operations.Option) (*operations.ReconcileResponse, error)
+	GetReconciliation(ctx context.Context, request operations.GetReconciliationRequest, opts ...operations.Option) (*operations.GetReconciliationResponse, error)
+	ListReconciliations(ctx context.Context, request operations.ListReconciliationsRequest, opts ...operations.Option) (*operations.ListReconciliationsResponse, error)
 }
 
 var _ ReconciliationSdkImpl = &defaultReconciliationSdk{}
@@ -32,6 +36,22 @@ func (s *defaultReconciliationSdk) DeletePolicy(ctx context.Context, request ope
 	return s.V1.DeletePolicy(ctx, request, opts...)
 }
 
+func (s *defaultReconciliationSdk) ListPolicies(ctx context.Context, request operations.ListPoliciesRequest, opts ...operations.Option) (*operations.ListPoliciesResponse, error) {
+	return s.V1.ListPolicies(ctx, request, opts...)
+}
+
+func (s *defaultReconciliationSdk) Reconcile(ctx context.Context, request operations.ReconcileRequest, opts ...operations.Option) (*operations.ReconcileResponse, error) {
+	return s.V1.Reconcile(ctx, request, opts...)
+}
+
+func (s *defaultReconciliationSdk) GetReconciliation(ctx context.Context, request operations.GetReconciliationRequest, opts ...operations.Option) (*operations.GetReconciliationResponse, error) {
+	return s.V1.GetReconciliation(ctx, request, opts...)
+}
+
+func (s *defaultReconciliationSdk) ListReconciliations(ctx context.Context, request operations.ListReconciliationsRequest, opts ...operations.Option) (*operations.ListReconciliationsResponse, error) {
+	return s.V1.ListReconciliations(ctx, request, opts...)
+}
+
 func newReconciliationSdk(reconciliation *formance.Reconciliation) ReconciliationSdkImpl {
 	return &defaultReconciliationSdk{
 		Reconciliation: reconciliation,
@@ -173,3 +173,179 @@ func (c *MockReconciliationSdkImplGetPolicyCall) DoAndReturn(f func(context.Cont
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetReconciliation mocks base method.
+func (m *MockReconciliationSdkImpl) GetReconciliation(ctx context.Context, request operations.GetReconciliationRequest, opts ...operations.Option) (*operations.GetReconciliationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReconciliation", varargs...)
+	ret0, _ := ret[0].(*operations.GetReconciliationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliation indicates an expected call of GetReconciliation.
+func (mr *MockReconciliationSdkImplMockRecorder) GetReconciliation(ctx, request any, opts ...any) *MockReconciliationSdkImplGetReconciliationCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliation", reflect.TypeOf((*MockReconciliationSdkImpl)(nil).GetReconciliation), varargs...)
+	return &MockReconciliationSdkImplGetReconciliationCall{Call: call}
+}
+
+// MockReconciliationSdkImplGetReconciliationCall wrap *gomock.Call
+type MockReconciliationSdkImplGetReconciliationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReconciliationSdkImplGetReconciliationCall) Return(arg0 *operations.GetReconciliationResponse, arg1 error) *MockReconciliationSdkImplGetReconciliationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReconciliationSdkImplGetReconciliationCall) Do(f func(context.Context, operations.GetReconciliationRequest, ...operations.Option) (*operations.GetReconciliationResponse, error)) *MockReconciliationSdkImplGetReconciliationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReconciliationSdkImplGetReconciliationCall) DoAndReturn(f func(context.Context, operations.GetReconciliationRequest, ...operations.Option) (*operations.GetReconciliationResponse, error)) *MockReconciliationSdkImplGetReconciliationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPolicies mocks base method.
+func (m *MockReconciliationSdkImpl) ListPolicies(ctx context.Context, request operations.ListPoliciesRequest, opts ...operations.Option) (*operations.ListPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPolicies", varargs...)
+	ret0, _ := ret[0].(*operations.ListPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockReconciliationSdkImplMockRecorder) ListPolicies(ctx, request any, opts ...any) *MockReconciliationSdkImplListPoliciesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockReconciliationSdkImpl)(nil).ListPolicies), varargs...)
+	return &MockReconciliationSdkImplListPoliciesCall{Call: call}
+}
+
+// MockReconciliationSdkImplListPoliciesCall wrap *gomock.Call
+type MockReconciliationSdkImplListPoliciesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReconciliationSdkImplListPoliciesCall) Return(arg0 *operations.ListPoliciesResponse, arg1 error) *MockReconciliationSdkImplListPoliciesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReconciliationSdkImplListPoliciesCall) Do(f func(context.Context, operations.ListPoliciesRequest, ...operations.Option) (*operations.ListPoliciesResponse, error)) *MockReconciliationSdkImplListPoliciesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReconciliationSdkImplListPoliciesCall) DoAndReturn(f func(context.Context, operations.ListPoliciesRequest, ...operations.Option) (*operations.ListPoliciesResponse, error)) *MockReconciliationSdkImplListPoliciesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListReconciliations mocks base method.
+func (m *MockReconciliationSdkImpl) ListReconciliations(ctx context.Context, request operations.ListReconciliationsRequest, opts ...operations.Option) (*operations.ListReconciliationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListReconciliations", varargs...)
+	ret0, _ := ret[0].(*operations.ListReconciliationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReconciliations indicates an expected call of ListReconciliations.
+func (mr *MockReconciliationSdkImplMockRecorder) ListReconciliations(ctx, request any, opts ...any) *MockReconciliationSdkImplListReconciliationsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReconciliations", reflect.TypeOf((*MockReconciliationSdkImpl)(nil).ListReconciliations), varargs...)
+	return &MockReconciliationSdkImplListReconciliationsCall{Call: call}
+}
+
+// MockReconciliationSdkImplListReconciliationsCall wrap *gomock.Call
+type MockReconciliationSdkImplListReconciliationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReconciliationSdkImplListReconciliationsCall) Return(arg0 *operations.ListReconciliationsResponse, arg1 error) *MockReconciliationSdkImplListReconciliationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReconciliationSdkImplListReconciliationsCall) Do(f func(context.Context, operations.ListReconciliationsRequest, ...operations.Option) (*operations.ListReconciliationsResponse, error)) *MockReconciliationSdkImplListReconciliationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReconciliationSdkImplListReconciliationsCall) DoAndReturn(f func(context.Context, operations.ListReconciliationsRequest, ...operations.Option) (*operations.ListReconciliationsResponse, error)) *MockReconciliationSdkImplListReconciliationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Reconcile mocks base method.
+func (m *MockReconciliationSdkImpl) Reconcile(ctx context.Context, request operations.ReconcileRequest, opts ...operations.Option) (*operations.ReconcileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Reconcile", varargs...)
+	ret0, _ := ret[0].(*operations.ReconcileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconciliationSdkImplMockRecorder) Reconcile(ctx, request any, opts ...any) *MockReconciliationSdkImplReconcileCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconciliationSdkImpl)(nil).Reconcile), varargs...)
+	return &MockReconciliationSdkImplReconcileCall{Call: call}
+}
+
+// MockReconciliationSdkImplReconcileCall wrap *gomock.Call
+type MockReconciliationSdkImplReconcileCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockReconciliationSdkImplReconcileCall) Return(arg0 *operations.ReconcileResponse, arg1 error) *MockReconciliationSdkImplReconcileCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockReconciliationSdkImplReconcileCall) Do(f func(context.Context, operations.ReconcileRequest, ...operations.Option) (*operations.ReconcileResponse, error)) *MockReconciliationSdkImplReconcileCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockReconciliationSdkImplReconcileCall) DoAndReturn(f func(context.Context, operations.ReconcileRequest, ...operations.Option) (*operations.ReconcileResponse, error)) *MockReconciliationSdkImplReconcileCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -14,6 +14,9 @@ type StackSdkImpl interface {
 	Payments() PaymentsSdkImpl
 	Webhooks() WebhooksSdkImpl
 	Reconciliation() ReconciliationSdkImpl
+	Orchestration() OrchestrationSdkImpl
+	Wallets() WalletsSdkImpl
+	Auth() AuthSdkImpl
 }
 
 var _ StackSdkImpl = &defaultStackSdk{}
@@ -24,6 +27,9 @@ type defaultStackSdk struct {
 	PaymentsSdkImpl
 	WebhooksSdkImpl
 	ReconciliationSdkImpl
+	OrchestrationSdkImpl
+	WalletsSdkImpl
+	AuthSdkImpl
 }
 
 func (s *defaultStackSdk) GetVersions(ctx context.Context) (*operations.GetVersionsResponse, error) {
@@ -42,6 +48,15 @@ func (s *defaultStackSdk) Webhooks() WebhooksSdkImpl {
 func (s *defaultStackSdk) Reconciliation() ReconciliationSdkImpl {
 	return s.ReconciliationSdkImpl
 }
+func (s *defaultStackSdk) Orchestration() OrchestrationSdkImpl {
+	return s.OrchestrationSdkImpl
+}
+func (s *defaultStackSdk) Wallets() WalletsSdkImpl {
+	return s.WalletsSdkImpl
+}
+func (s *defaultStackSdk) Auth() AuthSdkImpl {
+	return s.AuthSdkImpl
+}
 
 type StackSdkFactory func(opts ...formance.SDKOption) StackSdkImpl
 
@@ -54,6 +69,9 @@ func NewStackSdk(opts ...formance.SDKOption) StackSdkFactory {
 			PaymentsSdkImpl:       newPaymentsSdk(c.Payments),
 			WebhooksSdkImpl:       newWebhooksSdk(c.Webhooks),
 			ReconciliationSdkImpl: newReconciliationSdk(c.Reconciliation),
+			OrchestrationSdkImpl:  newOrchestrationSdk(c.Orchestration),
+			WalletsSdkImpl:        newWalletsSdk(c.Wallets),
+			AuthSdkImpl:           newAuthSdk(c.Auth),
 		}
 	}
 }
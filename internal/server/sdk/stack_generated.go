@@ -41,6 +41,44 @@ func (m *MockStackSdkImpl) EXPECT() *MockStackSdkImplMockRecorder {
 	return m.recorder
 }
 
+// Auth mocks base method.
+func (m *MockStackSdkImpl) Auth() AuthSdkImpl {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth")
+	ret0, _ := ret[0].(AuthSdkImpl)
+	return ret0
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockStackSdkImplMockRecorder) Auth() *MockStackSdkImplAuthCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockStackSdkImpl)(nil).Auth))
+	return &MockStackSdkImplAuthCall{Call: call}
+}
+
+// MockStackSdkImplAuthCall wrap *gomock.Call
+type MockStackSdkImplAuthCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStackSdkImplAuthCall) Return(arg0 AuthSdkImpl) *MockStackSdkImplAuthCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStackSdkImplAuthCall) Do(f func() AuthSdkImpl) *MockStackSdkImplAuthCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStackSdkImplAuthCall) DoAndReturn(f func() AuthSdkImpl) *MockStackSdkImplAuthCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetVersions mocks base method.
 func (m *MockStackSdkImpl) GetVersions(ctx context.Context) (*operations.GetVersionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +156,44 @@ func (c *MockStackSdkImplLedgerCall) DoAndReturn(f func() LedgerSdkImpl) *MockSt
 	return c
 }
 
+// Orchestration mocks base method.
+func (m *MockStackSdkImpl) Orchestration() OrchestrationSdkImpl {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Orchestration")
+	ret0, _ := ret[0].(OrchestrationSdkImpl)
+	return ret0
+}
+
+// Orchestration indicates an expected call of Orchestration.
+func (mr *MockStackSdkImplMockRecorder) Orchestration() *MockStackSdkImplOrchestrationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Orchestration", reflect.TypeOf((*MockStackSdkImpl)(nil).Orchestration))
+	return &MockStackSdkImplOrchestrationCall{Call: call}
+}
+
+// MockStackSdkImplOrchestrationCall wrap *gomock.Call
+type MockStackSdkImplOrchestrationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStackSdkImplOrchestrationCall) Return(arg0 OrchestrationSdkImpl) *MockStackSdkImplOrchestrationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStackSdkImplOrchestrationCall) Do(f func() OrchestrationSdkImpl) *MockStackSdkImplOrchestrationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStackSdkImplOrchestrationCall) DoAndReturn(f func() OrchestrationSdkImpl) *MockStackSdkImplOrchestrationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Payments mocks base method.
 func (m *MockStackSdkImpl) Payments() PaymentsSdkImpl {
 	m.ctrl.T.Helper()
@@ -194,6 +270,44 @@ func (c *MockStackSdkImplReconciliationCall) DoAndReturn(f func() Reconciliation
 	return c
 }
 
+// Wallets mocks base method.
+func (m *MockStackSdkImpl) Wallets() WalletsSdkImpl {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wallets")
+	ret0, _ := ret[0].(WalletsSdkImpl)
+	return ret0
+}
+
+// Wallets indicates an expected call of Wallets.
+func (mr *MockStackSdkImplMockRecorder) Wallets() *MockStackSdkImplWalletsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wallets", reflect.TypeOf((*MockStackSdkImpl)(nil).Wallets))
+	return &MockStackSdkImplWalletsCall{Call: call}
+}
+
+// MockStackSdkImplWalletsCall wrap *gomock.Call
+type MockStackSdkImplWalletsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStackSdkImplWalletsCall) Return(arg0 WalletsSdkImpl) *MockStackSdkImplWalletsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStackSdkImplWalletsCall) Do(f func() WalletsSdkImpl) *MockStackSdkImplWalletsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStackSdkImplWalletsCall) DoAndReturn(f func() WalletsSdkImpl) *MockStackSdkImplWalletsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Webhooks mocks base method.
 func (m *MockStackSdkImpl) Webhooks() WebhooksSdkImpl {
 	m.ctrl.T.Helper()
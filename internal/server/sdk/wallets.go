@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+)
+
+//go:generate mockgen -typed -destination=wallets_generated.go -package=sdk . WalletsSdkImpl
+type WalletsSdkImpl interface {
+	CreateWallet(ctx context.Context, request operations.CreateWalletRequest, opts ...operations.Option) (*operations.CreateWalletResponse, error)
+	UpdateWallet(ctx context.Context, request operations.UpdateWalletRequest, opts ...operations.Option) (*operations.UpdateWalletResponse, error)
+	GetWallet(ctx context.Context, request operations.GetWalletRequest, opts ...operations.Option) (*operations.GetWalletResponse, error)
+	ListWallets(ctx context.Context, request operations.ListWalletsRequest, opts ...operations.Option) (*operations.ListWalletsResponse, error)
+	GetWalletSummary(ctx context.Context, request operations.GetWalletSummaryRequest, opts ...operations.Option) (*operations.GetWalletSummaryResponse, error)
+	CreateBalance(ctx context.Context, request operations.CreateBalanceRequest, opts ...operations.Option) (*operations.CreateBalanceResponse, error)
+	GetBalance(ctx context.Context, request operations.GetBalanceRequest, opts ...operations.Option) (*operations.GetBalanceResponse, error)
+	ListBalances(ctx context.Context, request operations.ListBalancesRequest, opts ...operations.Option) (*operations.ListBalancesResponse, error)
+}
+
+var _ WalletsSdkImpl = &defaultWalletsSdk{}
+
+type defaultWalletsSdk struct {
+	*formance.Wallets
+}
+
+func (s *defaultWalletsSdk) CreateWallet(ctx context.Context, request operations.CreateWalletRequest, opts ...operations.Option) (*operations.CreateWalletResponse, error) {
+	return s.V1.CreateWallet(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) UpdateWallet(ctx context.Context, request operations.UpdateWalletRequest, opts ...operations.Option) (*operations.UpdateWalletResponse, error) {
+	return s.V1.UpdateWallet(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) GetWallet(ctx context.Context, request operations.GetWalletRequest, opts ...operations.Option) (*operations.GetWalletResponse, error) {
+	return s.V1.GetWallet(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) ListWallets(ctx context.Context, request operations.ListWalletsRequest, opts ...operations.Option) (*operations.ListWalletsResponse, error) {
+	return s.V1.ListWallets(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) GetWalletSummary(ctx context.Context, request operations.GetWalletSummaryRequest, opts ...operations.Option) (*operations.GetWalletSummaryResponse, error) {
+	return s.V1.GetWalletSummary(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) CreateBalance(ctx context.Context, request operations.CreateBalanceRequest, opts ...operations.Option) (*operations.CreateBalanceResponse, error) {
+	return s.V1.CreateBalance(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) GetBalance(ctx context.Context, request operations.GetBalanceRequest, opts ...operations.Option) (*operations.GetBalanceResponse, error) {
+	return s.V1.GetBalance(ctx, request, opts...)
+}
+
+func (s *defaultWalletsSdk) ListBalances(ctx context.Context, request operations.ListBalancesRequest, opts ...operations.Option) (*operations.ListBalancesResponse, error) {
+	return s.V1.ListBalances(ctx, request, opts...)
+}
+
+func newWalletsSdk(wallets *formance.Wallets) WalletsSdkImpl {
+	return &defaultWalletsSdk{
+		Wallets: wallets,
+	}
+}
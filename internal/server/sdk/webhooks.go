@@ -14,6 +14,10 @@ type WebhooksSdkImpl interface {
 	GetManyConfigs(ctx context.Context, request operations.GetManyConfigsRequest) (*operations.GetManyConfigsResponse, error)
 	DeleteConfig(ctx context.Context, request operations.DeleteConfigRequest, opts ...operations.Option) (*operations.DeleteConfigResponse, error)
 	UpdateConfig(ctx context.Context, request operations.UpdateConfigRequest, opts ...operations.Option) (*operations.UpdateConfigResponse, error)
+	ActivateConfig(ctx context.Context, request operations.ActivateConfigRequest, opts ...operations.Option) (*operations.ActivateConfigResponse, error)
+	DeactivateConfig(ctx context.Context, request operations.DeactivateConfigRequest, opts ...operations.Option) (*operations.DeactivateConfigResponse, error)
+	ChangeConfigSecret(ctx context.Context, request operations.ChangeConfigSecretRequest, opts ...operations.Option) (*operations.ChangeConfigSecretResponse, error)
+	TestConfig(ctx context.Context, request operations.TestConfigRequest, opts ...operations.Option) (*operations.TestConfigResponse, error)
 }
 
 var _ WebhooksSdkImpl = &defaultWebhooksSdk{}
@@ -34,6 +38,18 @@ func (s *defaultWebhooksSdk) DeleteConfig(ctx context.Context, request operation
 func (s *defaultWebhooksSdk) UpdateConfig(ctx context.Context, request operations.UpdateConfigRequest, opts ...operations.Option) (*operations.UpdateConfigResponse, error) {
 	return s.V1.UpdateConfig(ctx, request, opts...)
 }
+func (s *defaultWebhooksSdk) ActivateConfig(ctx context.Context, request operations.ActivateConfigRequest, opts ...operations.Option) (*operations.ActivateConfigResponse, error) {
+	return s.V1.ActivateConfig(ctx, request, opts...)
+}
+func (s *defaultWebhooksSdk) DeactivateConfig(ctx context.Context, request operations.DeactivateConfigRequest, opts ...operations.Option) (*operations.DeactivateConfigResponse, error) {
+	return s.V1.DeactivateConfig(ctx, request, opts...)
+}
+func (s *defaultWebhooksSdk) ChangeConfigSecret(ctx context.Context, request operations.ChangeConfigSecretRequest, opts ...operations.Option) (*operations.ChangeConfigSecretResponse, error) {
+	return s.V1.ChangeConfigSecret(ctx, request, opts...)
+}
+func (s *defaultWebhooksSdk) TestConfig(ctx context.Context, request operations.TestConfigRequest, opts ...operations.Option) (*operations.TestConfigResponse, error) {
+	return s.V1.TestConfig(ctx, request, opts...)
+}
 
 func newWebhooksSdk(webhooks *formance.Webhooks) WebhooksSdkImpl {
 	return &defaultWebhooksSdk{
@@ -42,6 +42,138 @@ func (m *MockWebhooksSdkImpl) EXPECT() *MockWebhooksSdkImplMockRecorder {
 	return m.recorder
 }
 
+// ActivateConfig mocks base method.
+func (m *MockWebhooksSdkImpl) ActivateConfig(ctx context.Context, request operations.ActivateConfigRequest, opts ...operations.Option) (*operations.ActivateConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActivateConfig", varargs...)
+	ret0, _ := ret[0].(*operations.ActivateConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateConfig indicates an expected call of ActivateConfig.
+func (mr *MockWebhooksSdkImplMockRecorder) ActivateConfig(ctx, request any, opts ...any) *MockWebhooksSdkImplActivateConfigCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateConfig", reflect.TypeOf((*MockWebhooksSdkImpl)(nil).ActivateConfig), varargs...)
+	return &MockWebhooksSdkImplActivateConfigCall{Call: call}
+}
+
+// MockWebhooksSdkImplActivateConfigCall wrap *gomock.Call
+type MockWebhooksSdkImplActivateConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWebhooksSdkImplActivateConfigCall) Return(arg0 *operations.ActivateConfigResponse, arg1 error) *MockWebhooksSdkImplActivateConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWebhooksSdkImplActivateConfigCall) Do(f func(context.Context, operations.ActivateConfigRequest, ...operations.Option) (*operations.ActivateConfigResponse, error)) *MockWebhooksSdkImplActivateConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWebhooksSdkImplActivateConfigCall) DoAndReturn(f func(context.Context, operations.ActivateConfigRequest, ...operations.Option) (*operations.ActivateConfigResponse, error)) *MockWebhooksSdkImplActivateConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ChangeConfigSecret mocks base method.
+func (m *MockWebhooksSdkImpl) ChangeConfigSecret(ctx context.Context, request operations.ChangeConfigSecretRequest, opts ...operations.Option) (*operations.ChangeConfigSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeConfigSecret", varargs...)
+	ret0, _ := ret[0].(*operations.ChangeConfigSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeConfigSecret indicates an expected call of ChangeConfigSecret.
+func (mr *MockWebhooksSdkImplMockRecorder) ChangeConfigSecret(ctx, request any, opts ...any) *MockWebhooksSdkImplChangeConfigSecretCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeConfigSecret", reflect.TypeOf((*MockWebhooksSdkImpl)(nil).ChangeConfigSecret), varargs...)
+	return &MockWebhooksSdkImplChangeConfigSecretCall{Call: call}
+}
+
+// MockWebhooksSdkImplChangeConfigSecretCall wrap *gomock.Call
+type MockWebhooksSdkImplChangeConfigSecretCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWebhooksSdkImplChangeConfigSecretCall) Return(arg0 *operations.ChangeConfigSecretResponse, arg1 error) *MockWebhooksSdkImplChangeConfigSecretCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWebhooksSdkImplChangeConfigSecretCall) Do(f func(context.Context, operations.ChangeConfigSecretRequest, ...operations.Option) (*operations.ChangeConfigSecretResponse, error)) *MockWebhooksSdkImplChangeConfigSecretCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWebhooksSdkImplChangeConfigSecretCall) DoAndReturn(f func(context.Context, operations.ChangeConfigSecretRequest, ...operations.Option) (*operations.ChangeConfigSecretResponse, error)) *MockWebhooksSdkImplChangeConfigSecretCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeactivateConfig mocks base method.
+func (m *MockWebhooksSdkImpl) DeactivateConfig(ctx context.Context, request operations.DeactivateConfigRequest, opts ...operations.Option) (*operations.DeactivateConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeactivateConfig", varargs...)
+	ret0, _ := ret[0].(*operations.DeactivateConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateConfig indicates an expected call of DeactivateConfig.
+func (mr *MockWebhooksSdkImplMockRecorder) DeactivateConfig(ctx, request any, opts ...any) *MockWebhooksSdkImplDeactivateConfigCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateConfig", reflect.TypeOf((*MockWebhooksSdkImpl)(nil).DeactivateConfig), varargs...)
+	return &MockWebhooksSdkImplDeactivateConfigCall{Call: call}
+}
+
+// MockWebhooksSdkImplDeactivateConfigCall wrap *gomock.Call
+type MockWebhooksSdkImplDeactivateConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWebhooksSdkImplDeactivateConfigCall) Return(arg0 *operations.DeactivateConfigResponse, arg1 error) *MockWebhooksSdkImplDeactivateConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWebhooksSdkImplDeactivateConfigCall) Do(f func(context.Context, operations.DeactivateConfigRequest, ...operations.Option) (*operations.DeactivateConfigResponse, error)) *MockWebhooksSdkImplDeactivateConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWebhooksSdkImplDeactivateConfigCall) DoAndReturn(f func(context.Context, operations.DeactivateConfigRequest, ...operations.Option) (*operations.DeactivateConfigResponse, error)) *MockWebhooksSdkImplDeactivateConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteConfig mocks base method.
 func (m *MockWebhooksSdkImpl) DeleteConfig(ctx context.Context, request operations.DeleteConfigRequest, opts ...operations.Option) (*operations.DeleteConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +296,50 @@ func (c *MockWebhooksSdkImplInsertConfigCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// TestConfig mocks base method.
+func (m *MockWebhooksSdkImpl) TestConfig(ctx context.Context, request operations.TestConfigRequest, opts ...operations.Option) (*operations.TestConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, request}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TestConfig", varargs...)
+	ret0, _ := ret[0].(*operations.TestConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestConfig indicates an expected call of TestConfig.
+func (mr *MockWebhooksSdkImplMockRecorder) TestConfig(ctx, request any, opts ...any) *MockWebhooksSdkImplTestConfigCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, request}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestConfig", reflect.TypeOf((*MockWebhooksSdkImpl)(nil).TestConfig), varargs...)
+	return &MockWebhooksSdkImplTestConfigCall{Call: call}
+}
+
+// MockWebhooksSdkImplTestConfigCall wrap *gomock.Call
+type MockWebhooksSdkImplTestConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWebhooksSdkImplTestConfigCall) Return(arg0 *operations.TestConfigResponse, arg1 error) *MockWebhooksSdkImplTestConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWebhooksSdkImplTestConfigCall) Do(f func(context.Context, operations.TestConfigRequest, ...operations.Option) (*operations.TestConfigResponse, error)) *MockWebhooksSdkImplTestConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWebhooksSdkImplTestConfigCall) DoAndReturn(f func(context.Context, operations.TestConfigRequest, ...operations.Option) (*operations.TestConfigResponse, error)) *MockWebhooksSdkImplTestConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateConfig mocks base method.
 func (m *MockWebhooksSdkImpl) UpdateConfig(ctx context.Context, request operations.UpdateConfigRequest, opts ...operations.Option) (*operations.UpdateConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
 	"github.com/formancehq/go-libs/v3/collectionutils"
 	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
@@ -19,6 +20,7 @@ type Store struct {
 	sdk.CloudSDK
 
 	WaitModuleTimeout time.Duration
+	WaitTaskTimeout   time.Duration
 }
 
 func (s *Store) NewModuleStore(module string) *ModuleStore {
@@ -27,6 +29,7 @@ func (s *Store) NewModuleStore(module string) *ModuleStore {
 		Stack:             s.Stack,
 		StackSdkImpl:      s.StackSdkImpl,
 		WaitModuleTimeout: s.WaitModuleTimeout,
+		WaitTaskTimeout:   s.WaitTaskTimeout,
 	}
 }
 
@@ -36,6 +39,7 @@ type ModuleStore struct {
 	sdk.StackSdkImpl
 
 	WaitModuleTimeout time.Duration
+	WaitTaskTimeout   time.Duration
 }
 
 func (ms *ModuleStore) CheckModuleHealth(ctx context.Context, diagnostics *diag.Diagnostics) {
@@ -77,3 +81,47 @@ func (ms *ModuleStore) CheckModuleHealth(ctx context.Context, diagnostics *diag.
 		}
 	}
 }
+
+// WaitPaymentsTask polls the payments task until it succeeds or fails.
+// A failed task is reported as an error diagnostic, the last known task is returned in both cases.
+func (ms *ModuleStore) WaitPaymentsTask(ctx context.Context, taskID string, diagnostics *diag.Diagnostics) *shared.V3Task {
+	ctx, span := otlp.Tracer.Start(ctx, "WaitPaymentsTask")
+	defer span.End()
+
+	timeout := time.After(ms.WaitTaskTimeout)
+	for {
+		resp, err := ms.Payments().GetTask(ctx, operations.V3GetTaskRequest{
+			TaskID: taskID,
+		})
+		if err != nil {
+			sdk.HandleStackError(ctx, err, diagnostics)
+			return nil
+		}
+
+		task := resp.V3GetTaskResponse.Data
+		switch task.Status {
+		case shared.V3TaskStatusEnumSucceeded:
+			return &task
+		case shared.V3TaskStatusEnumFailed:
+			reason := "no error reported"
+			if task.Error != nil {
+				reason = *task.Error
+			}
+			diagnostics.AddError("Payments Task Failed",
+				fmt.Sprintf("The payments task '%s' failed: %s", taskID, reason))
+			return &task
+		}
+
+		select {
+		case <-ctx.Done():
+			diagnostics.AddError("Payments Task Cancelled",
+				fmt.Sprintf("Waiting for the payments task '%s' was cancelled: %s", taskID, ctx.Err()))
+			return &task
+		case <-timeout:
+			diagnostics.AddError("Payments Task Timeout",
+				fmt.Sprintf("The payments task '%s' did not complete within the timeout period of %s.", taskID, ms.WaitTaskTimeout))
+			return &task
+		case <-time.After(time.Second * 2):
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestAuthClientSecret(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		authSdk := sdk.NewMockAuthSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "auth_client_secret"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "auth",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Auth().Return(authSdk).AnyTimes()
+
+		clientID := uuid.NewString()
+
+		// The secrets of the client as stored by the server, the first one must outlive the creation of its replacement
+		secrets := []shared.ClientSecret{}
+		created := 0
+		authSdk.EXPECT().CreateSecret(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.CreateSecretRequest, _ ...operations.Option) (*operations.CreateSecretResponse, error) {
+			require.Equal(t, clientID, request.ClientID)
+			created++
+			clearSecret := uuid.NewString()
+			secret := shared.ClientSecret{
+				ID:         uuid.NewString(),
+				Name:       request.CreateSecretRequest.Name,
+				LastDigits: clearSecret[len(clearSecret)-4:],
+				Metadata:   request.CreateSecretRequest.Metadata,
+			}
+			secrets = append(secrets, secret)
+			return &operations.CreateSecretResponse{
+				CreateSecretResponse: &shared.CreateSecretResponse{
+					Data: &shared.Secret{
+						Clear:      clearSecret,
+						ID:         secret.ID,
+						Name:       secret.Name,
+						LastDigits: secret.LastDigits,
+						Metadata:   secret.Metadata,
+					},
+				},
+			}, nil
+		}).Times(2)
+
+		authSdk.EXPECT().ReadClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.ReadClientRequest, _ ...operations.Option) (*operations.ReadClientResponse, error) {
+			return &operations.ReadClientResponse{
+				ReadClientResponse: &shared.ReadClientResponse{
+					Data: &shared.Client{
+						ID:      request.ClientID,
+						Name:    "payouts",
+						Secrets: secrets,
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		authSdk.EXPECT().DeleteSecret(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.DeleteSecretRequest, _ ...operations.Option) (*operations.DeleteSecretResponse, error) {
+			require.Equal(t, 2, created)
+			secrets = slices.DeleteFunc(secrets, func(secret shared.ClientSecret) bool {
+				return secret.ID == request.SecretID
+			})
+			return &operations.DeleteSecretResponse{}, nil
+		}).Times(2)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		secretConfig := func(rotation string) string {
+			return providerConfig + `
+			resource "stack_auth_client_secret" "payouts" {
+				client_id        = "` + clientID + `"
+				name             = "payouts"
+				metadata         = { owner = "payouts" }
+				rotation_trigger = "` + rotation + `"
+
+				lifecycle {
+					create_before_destroy = true
+				}
+			}
+			`
+		}
+
+		var firstSecretID string
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: secretConfig("2026-09"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_auth_client_secret.payouts", tfjsonpath.New("secret"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("stack_auth_client_secret.payouts", tfjsonpath.New("id"), knownvalue.StringFunc(func(v string) error {
+							firstSecretID = v
+							return nil
+						})),
+						statecheck.ExpectKnownValue("stack_auth_client_secret.payouts", tfjsonpath.New("last_digits"), knownvalue.StringFunc(func(v string) error {
+							if len(secrets) != 1 || secrets[0].LastDigits != v {
+								return fmt.Errorf("expected the last digits of the secret, got %s", v)
+							}
+							return nil
+						})),
+					},
+				},
+				{
+					Config: secretConfig("2026-10"),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("stack_auth_client_secret.payouts", plancheck.ResourceActionCreateBeforeDestroy),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_auth_client_secret.payouts", tfjsonpath.New("id"), knownvalue.StringFunc(func(v string) error {
+							if v == firstSecretID {
+								return fmt.Errorf("expected the secret to be rotated")
+							}
+							if len(secrets) != 1 || secrets[0].ID != v {
+								return fmt.Errorf("expected the previous secret to be deleted")
+							}
+							return nil
+						})),
+					},
+				},
+				{
+					ResourceName: "stack_auth_client_secret.payouts",
+					ImportState:  true,
+					ImportStateIdFunc: func(*terraform.State) (string, error) {
+						return clientID + "/" + secrets[0].ID, nil
+					},
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"secret", "rotation_trigger"},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,207 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestAuthClient(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		authSdk := sdk.NewMockAuthSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "auth_client"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "auth",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Auth().Return(authSdk).AnyTimes()
+
+		// The stack advertises its scopes in its OpenID Connect discovery document
+		authSdk.EXPECT().GetOIDCWellKnowns(gomock.Any()).DoAndReturn(func(_ context.Context, _ ...operations.Option) (*operations.GetOIDCWellKnownsResponse, error) {
+			return &operations.GetOIDCWellKnownsResponse{
+				StatusCode: http.StatusOK,
+				RawResponse: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"scopes_supported": ["openid", "ledger:read", "ledger:write", "payments:read"]}`)),
+				},
+			}, nil
+		}).AnyTimes()
+
+		// The clients as stored by the server
+		clients := map[string]shared.Client{}
+		save := func(id string, options *shared.ClientOptions) *shared.Client {
+			// The server returns an empty description when none is set
+			description := options.Description
+			if description == nil {
+				description = pointer.For("")
+			}
+			client := shared.Client{
+				ID:                     id,
+				Name:                   options.Name,
+				Description:            description,
+				Scopes:                 options.Scopes,
+				RedirectUris:           options.RedirectUris,
+				PostLogoutRedirectUris: options.PostLogoutRedirectUris,
+				Public:                 options.Public,
+				Trusted:                options.Trusted,
+				Metadata:               options.Metadata,
+			}
+			clients[id] = client
+			return &client
+		}
+
+		authSdk.EXPECT().CreateClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, options *shared.ClientOptions, _ ...operations.Option) (*operations.CreateClientResponse, error) {
+			return &operations.CreateClientResponse{
+				CreateClientResponse: &shared.CreateClientResponse{
+					Data: save(uuid.NewString(), options),
+				},
+			}, nil
+		}).Times(1)
+
+		authSdk.EXPECT().ReadClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.ReadClientRequest, _ ...operations.Option) (*operations.ReadClientResponse, error) {
+			client := clients[request.ClientID]
+			return &operations.ReadClientResponse{
+				ReadClientResponse: &shared.ReadClientResponse{
+					Data: &client,
+				},
+			}, nil
+		}).AnyTimes()
+
+		authSdk.EXPECT().UpdateClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.UpdateClientRequest, _ ...operations.Option) (*operations.UpdateClientResponse, error) {
+			return &operations.UpdateClientResponse{
+				CreateClientResponse: &shared.CreateClientResponse{
+					Data: save(request.ClientID, request.ClientOptions),
+				},
+			}, nil
+		}).Times(1)
+
+		authSdk.EXPECT().DeleteClient(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.DeleteClientRequest, _ ...operations.Option) (*operations.DeleteClientResponse, error) {
+			delete(clients, request.ClientID)
+			return &operations.DeleteClientResponse{}, nil
+		}).Times(1)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		clientConfig := func(scopes string) string {
+			return providerConfig + `
+			resource "stack_auth_client" "payouts" {
+				name     = "payouts"
+				scopes   = ` + scopes + `
+				metadata = { team = "payouts" }
+			}
+			`
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					// Scopes which are not advertised by the stack only produce warnings
+					Config:             clientConfig(`["ledger:read", "ledger:wrte"]`),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: clientConfig(`["ledger:read"]`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_auth_client.payouts", tfjsonpath.New("public"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("stack_auth_client.payouts", tfjsonpath.New("trusted"), knownvalue.Bool(false)),
+						statecheck.ExpectKnownValue("stack_auth_client.payouts", tfjsonpath.New("redirect_uris"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("stack_auth_client.payouts", tfjsonpath.New("description"), knownvalue.Null()),
+					},
+				},
+				{
+					Config: clientConfig(`["ledger:read", "payments:read"]`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("stack_auth_client.payouts", plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_auth_client.payouts", tfjsonpath.New("scopes"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("ledger:read"),
+							knownvalue.StringExact("payments:read"),
+						})),
+					},
+				},
+				{
+					ResourceName:      "stack_auth_client.payouts",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
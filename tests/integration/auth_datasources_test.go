@@ -0,0 +1,199 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestAuthDataSources(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		authSdk := sdk.NewMockAuthSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "auth_datasources"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "auth",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Auth().Return(authSdk).AnyTimes()
+
+		users := []shared.User{
+			{ID: pointer.For(uuid.NewString()), Subject: pointer.For("sub-zoe"), Email: pointer.For("zoe@example.com")},
+			{ID: pointer.For(uuid.NewString()), Subject: pointer.For("sub-alice"), Email: pointer.For("alice@example.com")},
+		}
+		clients := []shared.Client{
+			{
+				ID:      uuid.NewString(),
+				Name:    "payouts",
+				Scopes:  []string{"ledger:read"},
+				Trusted: pointer.For(false),
+				Secrets: []shared.ClientSecret{
+					{ID: uuid.NewString(), Name: "2026-10", LastDigits: "a1b2"},
+				},
+			},
+			{
+				ID:          uuid.NewString(),
+				Name:        "dashboard",
+				Description: pointer.For("Dashboard of the stack"),
+				Trusted:     pointer.For(true),
+				Metadata:    map[string]string{"owner": "platform"},
+			},
+		}
+
+		authSdk.EXPECT().ListUsers(gomock.Any()).Return(&operations.ListUsersResponse{
+			ListUsersResponse: &shared.ListUsersResponse{
+				Data: users,
+			},
+		}, nil).AnyTimes()
+		authSdk.EXPECT().ReadUser(gomock.Any(), operations.ReadUserRequest{
+			UserID: *users[1].ID,
+		}).Return(&operations.ReadUserResponse{
+			ReadUserResponse: &shared.ReadUserResponse{
+				Data: &users[1],
+			},
+		}, nil).AnyTimes()
+		authSdk.EXPECT().ListClients(gomock.Any()).Return(&operations.ListClientsResponse{
+			ListClientsResponse: &shared.ListClientsResponse{
+				Data: clients,
+			},
+		}, nil).AnyTimes()
+		authSdk.EXPECT().ReadClient(gomock.Any(), operations.ReadClientRequest{
+			ClientID: clients[0].ID,
+		}).Return(&operations.ReadClientResponse{
+			ReadClientResponse: &shared.ReadClientResponse{
+				Data: &clients[0],
+			},
+		}, nil).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+					data "stack_auth_users" "all" {}
+					data "stack_auth_users" "alice" {
+						email = "Alice@Example.com"
+					}
+					data "stack_auth_user" "alice" {
+						id = data.stack_auth_users.alice.users[0].id
+					}
+					data "stack_auth_clients" "trusted" {
+						trusted = true
+					}
+					data "stack_auth_client" "payouts" {
+						id = "` + clients[0].ID + `"
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_auth_users.all", tfjsonpath.New("users"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":      knownvalue.StringExact(*users[1].ID),
+								"subject": knownvalue.StringExact("sub-alice"),
+								"email":   knownvalue.StringExact("alice@example.com"),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":      knownvalue.StringExact(*users[0].ID),
+								"subject": knownvalue.StringExact("sub-zoe"),
+								"email":   knownvalue.StringExact("zoe@example.com"),
+							}),
+						})),
+						statecheck.ExpectKnownValue("data.stack_auth_users.alice", tfjsonpath.New("users"), knownvalue.ListSizeExact(1)),
+						statecheck.ExpectKnownValue("data.stack_auth_user.alice", tfjsonpath.New("email"), knownvalue.StringExact("alice@example.com")),
+						statecheck.ExpectKnownValue("data.stack_auth_clients.trusted", tfjsonpath.New("clients"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":                        knownvalue.StringExact(clients[1].ID),
+								"name":                      knownvalue.StringExact("dashboard"),
+								"description":               knownvalue.StringExact("Dashboard of the stack"),
+								"scopes":                    knownvalue.SetSizeExact(0),
+								"redirect_uris":             knownvalue.ListSizeExact(0),
+								"post_logout_redirect_uris": knownvalue.ListSizeExact(0),
+								"public":                    knownvalue.Bool(false),
+								"trusted":                   knownvalue.Bool(true),
+								"metadata": knownvalue.MapExact(map[string]knownvalue.Check{
+									"owner": knownvalue.StringExact("platform"),
+								}),
+								"secrets": knownvalue.ListSizeExact(0),
+							}),
+						})),
+						statecheck.ExpectKnownValue("data.stack_auth_client.payouts", tfjsonpath.New("scopes"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("ledger:read"),
+						})),
+						statecheck.ExpectKnownValue("data.stack_auth_client.payouts", tfjsonpath.New("secrets"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":          knownvalue.StringExact(clients[0].Secrets[0].ID),
+								"name":        knownvalue.StringExact("2026-10"),
+								"last_digits": knownvalue.StringExact("a1b2"),
+							}),
+						})),
+					},
+				},
+			},
+		})
+	})
+}
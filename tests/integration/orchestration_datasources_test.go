@@ -0,0 +1,238 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestOrchestrationDataSources(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		orchestrationSdk := sdk.NewMockOrchestrationSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "orchestration_datasources"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "orchestration",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Orchestration().Return(orchestrationSdk).AnyTimes()
+
+		workflowId := uuid.NewString()
+		triggerId := uuid.NewString()
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		terminatedAt := createdAt.Add(time.Minute)
+		instance := shared.V2WorkflowInstance{
+			ID:           uuid.NewString(),
+			WorkflowID:   workflowId,
+			CreatedAt:    createdAt.Add(time.Hour),
+			UpdatedAt:    terminatedAt.Add(time.Hour),
+			Terminated:   true,
+			TerminatedAt: pointer.For(terminatedAt.Add(time.Hour)),
+		}
+		running := shared.V2WorkflowInstance{
+			ID:         uuid.NewString(),
+			WorkflowID: workflowId,
+			CreatedAt:  createdAt,
+			UpdatedAt:  createdAt,
+		}
+
+		// The listing spans two pages to go through the cursor, the first page size is the limit
+		orchestrationSdk.EXPECT().ListInstances(gomock.Any(), operations.V2ListInstancesRequest{
+			WorkflowID: pointer.For(workflowId),
+			PageSize:   pointer.For(int64(20)),
+		}).Return(&operations.V2ListInstancesResponse{
+			V2ListRunsResponse: &shared.V2ListRunsResponse{
+				Cursor: shared.V2ListRunsResponseCursor{
+					Data:    []shared.V2WorkflowInstance{running},
+					HasMore: true,
+					Next:    pointer.For("next"),
+				},
+			},
+		}, nil).AnyTimes()
+		orchestrationSdk.EXPECT().ListInstances(gomock.Any(), operations.V2ListInstancesRequest{
+			Cursor: pointer.For("next"),
+		}).Return(&operations.V2ListInstancesResponse{
+			V2ListRunsResponse: &shared.V2ListRunsResponse{
+				Cursor: shared.V2ListRunsResponseCursor{
+					Data: []shared.V2WorkflowInstance{instance},
+				},
+			},
+		}, nil).AnyTimes()
+
+		orchestrationSdk.EXPECT().GetInstance(gomock.Any(), operations.V2GetInstanceRequest{
+			InstanceID: instance.ID,
+		}).Return(&operations.V2GetInstanceResponse{
+			V2GetWorkflowInstanceResponse: &shared.V2GetWorkflowInstanceResponse{
+				Data: instance,
+			},
+		}, nil).AnyTimes()
+
+		orchestrationSdk.EXPECT().GetInstanceHistory(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.V2GetInstanceHistoryRequest, _ ...operations.Option) (*operations.V2GetInstanceHistoryResponse, error) {
+			history := []shared.V2WorkflowInstanceHistory{}
+			if request.InstanceID == instance.ID {
+				history = append(history, shared.V2WorkflowInstanceHistory{
+					Name:         "delay",
+					Input:        shared.CreateV2StageV2StageDelay(shared.V2StageDelay{Duration: pointer.For("1m")}),
+					StartedAt:    instance.CreatedAt,
+					Terminated:   true,
+					TerminatedAt: instance.TerminatedAt,
+				})
+			}
+			return &operations.V2GetInstanceHistoryResponse{
+				V2GetWorkflowInstanceHistoryResponse: &shared.V2GetWorkflowInstanceHistoryResponse{
+					Data: history,
+				},
+			}, nil
+		}).AnyTimes()
+
+		orchestrationSdk.EXPECT().ListTriggersOccurrences(gomock.Any(), operations.V2ListTriggersOccurrencesRequest{
+			TriggerID: triggerId,
+			PageSize:  pointer.For(int64(1)),
+		}).Return(&operations.V2ListTriggersOccurrencesResponse{
+			V2ListTriggersOccurrencesResponse: &shared.V2ListTriggersOccurrencesResponse{
+				Cursor: shared.V2ListTriggersOccurrencesResponseCursor{
+					Data: []shared.V2TriggerOccurrence{
+						{
+							Date:               instance.CreatedAt,
+							TriggerID:          triggerId,
+							Event:              map[string]any{"amount": 2000},
+							WorkflowInstanceID: pointer.For(instance.ID),
+							WorkflowInstance:   &instance,
+						},
+					},
+					HasMore: true,
+					Next:    pointer.For("next"),
+				},
+			},
+		}, nil).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					data "stack_orchestration_instances" "payout" {
+						workflow_id = "` + workflowId + `"
+					}
+
+					data "stack_orchestration_trigger_occurrences" "payout" {
+						trigger_id = "` + triggerId + `"
+						limit      = 1
+					}
+
+					data "stack_orchestration_instance" "last" {
+						id = data.stack_orchestration_trigger_occurrences.payout.occurrences[0].workflow_instance_id
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_orchestration_instances.payout", tfjsonpath.New("instances"), knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.ObjectPartial(map[string]knownvalue.Check{
+									"id":            knownvalue.StringExact(instance.ID),
+									"status":        knownvalue.StringExact("SUCCEEDED"),
+									"terminated_at": knownvalue.StringExact("2025-01-02T04:05:05Z"),
+									"history":       knownvalue.ListSizeExact(1),
+								}),
+								knownvalue.ObjectPartial(map[string]knownvalue.Check{
+									"id":            knownvalue.StringExact(running.ID),
+									"status":        knownvalue.StringExact("RUNNING"),
+									"terminated_at": knownvalue.Null(),
+									"history":       knownvalue.ListSizeExact(0),
+								}),
+							},
+						)),
+						statecheck.ExpectKnownValue("data.stack_orchestration_trigger_occurrences.payout", tfjsonpath.New("occurrences"), knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"date":                     knownvalue.StringExact("2025-01-02T04:04:05Z"),
+									"event":                    knownvalue.StringExact(`{"amount":2000}`),
+									"error":                    knownvalue.Null(),
+									"workflow_instance_id":     knownvalue.StringExact(instance.ID),
+									"workflow_instance_status": knownvalue.StringExact("SUCCEEDED"),
+									"workflow_instance_error":  knownvalue.Null(),
+								}),
+							},
+						)),
+						statecheck.ExpectKnownValue("data.stack_orchestration_instance.last", tfjsonpath.New("history").AtSliceIndex(0), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":          knownvalue.StringExact("delay"),
+							"input":         knownvalue.StringExact(`{"duration":"1m"}`),
+							"error":         knownvalue.Null(),
+							"terminated":    knownvalue.Bool(true),
+							"started_at":    knownvalue.StringExact("2025-01-02T04:04:05Z"),
+							"terminated_at": knownvalue.StringExact("2025-01-02T04:05:05Z"),
+						})),
+					},
+				},
+			},
+		})
+	})
+}
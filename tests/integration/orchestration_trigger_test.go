@@ -0,0 +1,204 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestOrchestrationTrigger(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		orchestrationSdk := sdk.NewMockOrchestrationSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "orchestration_trigger"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "orchestration",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Orchestration().Return(orchestrationSdk).AnyTimes()
+
+		// The triggers as stored by the server
+		triggers := map[string]shared.V2Trigger{}
+
+		orchestrationSdk.EXPECT().CreateTrigger(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, data *shared.V2TriggerData, _ ...operations.Option) (*operations.V2CreateTriggerResponse, error) {
+			trigger := shared.V2Trigger{
+				ID:         uuid.NewString(),
+				Name:       data.Name,
+				WorkflowID: data.WorkflowID,
+				Event:      data.Event,
+				Filter:     data.Filter,
+				Vars:       data.Vars,
+				CreatedAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			}
+			triggers[trigger.ID] = trigger
+			return &operations.V2CreateTriggerResponse{
+				V2CreateTriggerResponse: &shared.V2CreateTriggerResponse{
+					Data: trigger,
+				},
+			}, nil
+		}).Times(2)
+
+		orchestrationSdk.EXPECT().ReadTrigger(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.V2ReadTriggerRequest, _ ...operations.Option) (*operations.V2ReadTriggerResponse, error) {
+			return &operations.V2ReadTriggerResponse{
+				V2ReadTriggerResponse: &shared.V2ReadTriggerResponse{
+					Data: triggers[request.TriggerID],
+				},
+			}, nil
+		}).AnyTimes()
+
+		// The filter `event.amount > 1000` is evaluated against the sample event, an unknown field fails it
+		orchestrationSdk.EXPECT().TestTrigger(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.TestTriggerRequest, _ ...operations.Option) (*operations.TestTriggerResponse, error) {
+			require.Contains(t, triggers, request.TriggerID)
+			amount, ok := request.RequestBody["amount"].(json.Number)
+			if !ok {
+				return &operations.TestTriggerResponse{
+					V2TestTriggerResponse: &shared.V2TestTriggerResponse{
+						Data: shared.V2TriggerTest{
+							Filter: &shared.Filter{Error: pointer.For("unknown field amount")},
+						},
+					},
+				}, nil
+			}
+			value, err := amount.Int64()
+			require.NoError(t, err)
+			return &operations.TestTriggerResponse{
+				V2TestTriggerResponse: &shared.V2TestTriggerResponse{
+					Data: shared.V2TriggerTest{
+						Filter: &shared.Filter{Match: pointer.For(value > 1000)},
+						Variables: map[string]shared.Variables{
+							"amount": {Value: pointer.For(amount.String())},
+						},
+					},
+				},
+			}, nil
+		}).MinTimes(3)
+
+		orchestrationSdk.EXPECT().DeleteTrigger(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.V2DeleteTriggerRequest, _ ...operations.Option) (*operations.V2DeleteTriggerResponse, error) {
+			delete(triggers, request.TriggerID)
+			return &operations.V2DeleteTriggerResponse{}, nil
+		}).Times(2)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		triggerConfig := func(sampleEvent string) string {
+			return providerConfig + `
+			resource "stack_orchestration_trigger" "payout" {
+				workflow_id  = "workflow"
+				event        = "SAVED_PAYMENT"
+				filter       = "event.amount > 1000"
+				vars         = { amount = "event.amount" }
+				sample_event = jsonencode(` + sampleEvent + `)
+			}
+			`
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: triggerConfig(`{ amount = 2000 }`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_orchestration_trigger.payout", tfjsonpath.New("created_at"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+						statecheck.ExpectKnownValue("stack_orchestration_trigger.payout", tfjsonpath.New("test_match"), knownvalue.Bool(true)),
+						statecheck.ExpectKnownValue("stack_orchestration_trigger.payout", tfjsonpath.New("test_vars"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"amount": knownvalue.StringExact("2000"),
+						})),
+					},
+				},
+				{
+					// The new sample event is tested at plan time against the existing trigger
+					Config: triggerConfig(`{ amount = 500 }`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("stack_orchestration_trigger.payout", plancheck.ResourceActionUpdate),
+							plancheck.ExpectKnownValue("stack_orchestration_trigger.payout", tfjsonpath.New("test_match"), knownvalue.Bool(false)),
+						},
+					},
+				},
+				{
+					Config:      triggerConfig(`{ amont = 500 }`),
+					ExpectError: regexp.MustCompile("Trigger Filter Failed"),
+				},
+				{
+					ResourceName:            "stack_orchestration_trigger.payout",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"sample_event", "test_match", "test_vars"},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,202 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestOrchestrationWorkflow(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		orchestrationSdk := sdk.NewMockOrchestrationSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "orchestration_workflow"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "orchestration",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Orchestration().Return(orchestrationSdk).AnyTimes()
+
+		// The workflows as stored by the server
+		workflows := map[string]shared.V2Workflow{}
+
+		orchestrationSdk.EXPECT().CreateWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, config *shared.V2WorkflowConfig, _ ...operations.Option) (*operations.V2CreateWorkflowResponse, error) {
+			workflow := shared.V2Workflow{
+				ID:        uuid.NewString(),
+				Config:    *config,
+				CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			}
+			workflows[workflow.ID] = workflow
+			return &operations.V2CreateWorkflowResponse{
+				V2CreateWorkflowResponse: &shared.V2CreateWorkflowResponse{
+					Data: workflow,
+				},
+			}, nil
+		}).Times(3)
+
+		orchestrationSdk.EXPECT().GetWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.V2GetWorkflowRequest, _ ...operations.Option) (*operations.V2GetWorkflowResponse, error) {
+			return &operations.V2GetWorkflowResponse{
+				V2GetWorkflowResponse: &shared.V2GetWorkflowResponse{
+					Data: workflows[request.FlowID],
+				},
+			}, nil
+		}).AnyTimes()
+
+		orchestrationSdk.EXPECT().DeleteWorkflow(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.V2DeleteWorkflowRequest, _ ...operations.Option) (*operations.V2DeleteWorkflowResponse, error) {
+			delete(workflows, request.FlowID)
+			return &operations.V2DeleteWorkflowResponse{}, nil
+		}).Times(3)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "stack_orchestration_workflow" "payout" {
+						name = "payout"
+						stages = [
+							jsonencode({ delay = { duration = "1h" } }),
+						]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_orchestration_workflow.payout", tfjsonpath.New("created_at"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+						statecheck.ExpectKnownValue("stack_orchestration_workflow.payout", tfjsonpath.New("stages"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact(`{"delay":{"duration":"1h"}}`),
+						})),
+					},
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_orchestration_workflow" "payout" {
+						name = "payout"
+						stages = [
+							jsonencode({ delay = { duration = "2h" } }),
+						]
+					}
+				`,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("stack_orchestration_workflow.payout", plancheck.ResourceActionReplace),
+						},
+					},
+				},
+				{
+					ResourceName:      "stack_orchestration_workflow.payout",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_orchestration_workflow" "payout" {
+						name      = "payout"
+						variables = ["wallet", "amount"]
+						stage {
+							send {
+								amount = "$${amount}"
+								asset  = "USD/2"
+								source {
+									account {
+										id = "world"
+									}
+								}
+								destination {
+									wallet {
+										id = "$${wallet}"
+									}
+								}
+							}
+						}
+						stage {
+							wait_event {
+								event = "confirmed"
+							}
+						}
+					}
+				`,
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("stack_orchestration_workflow.payout", plancheck.ResourceActionReplace),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_orchestration_workflow.payout", tfjsonpath.New("stage").AtSliceIndex(0).AtMapKey("send").AtMapKey("destination").AtMapKey("wallet").AtMapKey("id"), knownvalue.StringExact("${wallet}")),
+						statecheck.ExpectKnownValue("stack_orchestration_workflow.payout", tfjsonpath.New("stage").AtSliceIndex(0).AtMapKey("send").AtMapKey("amount"), knownvalue.StringExact("${amount}")),
+						statecheck.ExpectKnownValue("stack_orchestration_workflow.payout", tfjsonpath.New("stages"), knownvalue.Null()),
+					},
+				},
+			},
+		})
+	})
+}
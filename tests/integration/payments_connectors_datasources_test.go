@@ -0,0 +1,205 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestPaymentsConnectorsDataSources(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "payments_connectors_datasources"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "payments",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+		connectorId := uuid.NewString()
+		scheduleId := uuid.NewString()
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		// The listing spans two pages to go through the cursor
+		paymentsSdk.EXPECT().ListConnectors(gomock.Any(), operations.V3ListConnectorsRequest{}).Return(&operations.V3ListConnectorsResponse{
+			V3ConnectorsCursorResponse: &shared.V3ConnectorsCursorResponse{
+				Cursor: shared.V3ConnectorsCursorResponseCursor{
+					Data: []shared.V3Connector{
+						{ID: uuid.NewString(), Name: "stripe", Provider: "STRIPE", CreatedAt: createdAt},
+					},
+					HasMore: true,
+					Next:    pointer.For("next"),
+				},
+			},
+		}, nil).AnyTimes()
+		paymentsSdk.EXPECT().ListConnectors(gomock.Any(), operations.V3ListConnectorsRequest{
+			Cursor: pointer.For("next"),
+		}).Return(&operations.V3ListConnectorsResponse{
+			V3ConnectorsCursorResponse: &shared.V3ConnectorsCursorResponse{
+				Cursor: shared.V3ConnectorsCursorResponseCursor{
+					Data: []shared.V3Connector{
+						{ID: connectorId, Name: "Example Connector", Provider: "GENERIC", Reference: "ref", CreatedAt: createdAt},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		paymentsSdk.EXPECT().ListConnectorSchedules(gomock.Any(), operations.V3ListConnectorSchedulesRequest{
+			ConnectorID: connectorId,
+		}).Return(&operations.V3ListConnectorSchedulesResponse{
+			V3ConnectorSchedulesCursorResponse: &shared.V3ConnectorSchedulesCursorResponse{
+				Cursor: shared.V3ConnectorSchedulesCursorResponseCursor{
+					Data: []shared.V3Schedule{
+						{ID: scheduleId, ConnectorID: connectorId, CreatedAt: createdAt},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		paymentsSdk.EXPECT().ListConnectorScheduleInstances(gomock.Any(), operations.V3ListConnectorScheduleInstancesRequest{
+			ConnectorID: connectorId,
+			ScheduleID:  scheduleId,
+			PageSize:    pointer.For(int64(20)),
+		}).Return(&operations.V3ListConnectorScheduleInstancesResponse{
+			V3ConnectorScheduleInstancesCursorResponse: &shared.V3ConnectorScheduleInstancesCursorResponse{
+				Cursor: shared.V3ConnectorScheduleInstancesCursorResponseCursor{
+					Data: []shared.V3Instance{
+						{ID: "run-1", ConnectorID: connectorId, ScheduleID: scheduleId, CreatedAt: createdAt, UpdatedAt: createdAt, Terminated: true, TerminatedAt: pointer.For(createdAt)},
+						{ID: "run-2", ConnectorID: connectorId, ScheduleID: scheduleId, CreatedAt: createdAt.Add(time.Minute), UpdatedAt: createdAt.Add(time.Minute), Error: pointer.For("rate limited")},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		paymentsSdk.EXPECT().GetConnector(gomock.Any(), operations.V3GetConnectorConfigRequest{
+			ConnectorID: connectorId,
+		}).Return(&operations.V3GetConnectorConfigResponse{
+			V3GetConnectorConfigResponse: &shared.V3GetConnectorConfigResponse{
+				Data: shared.V3InstallConnectorRequest{
+					Type: "Generic",
+					V3GenericConfig: &shared.V3GenericConfig{
+						APIKey:   "********",
+						Endpoint: "https://api.example.com",
+						Name:     "Example Connector",
+						Provider: pointer.For("Generic"),
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					data "stack_payments_connectors" "generic" {
+						connector_provider = "Generic"
+					}
+
+					data "stack_payments_connector" "generic" {
+						id = data.stack_payments_connectors.generic.connectors[0].id
+					}
+
+					data "stack_payments_connector_schedules" "generic" {
+						connector_id = data.stack_payments_connectors.generic.connectors[0].id
+					}
+
+					data "stack_payments_connector_schedule_instances" "generic" {
+						connector_id = data.stack_payments_connectors.generic.connectors[0].id
+						schedule_id = data.stack_payments_connector_schedules.generic.schedules[0].id
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_payments_connectors.generic", tfjsonpath.New("connectors"), knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"id":                     knownvalue.StringExact(connectorId),
+									"name":                   knownvalue.StringExact("Example Connector"),
+									"provider":               knownvalue.StringExact("GENERIC"),
+									"reference":              knownvalue.StringExact("ref"),
+									"created_at":             knownvalue.StringExact("2025-01-02T03:04:05Z"),
+									"scheduled_for_deletion": knownvalue.Bool(false),
+								}),
+							},
+						)),
+						statecheck.ExpectKnownValue("data.stack_payments_connector.generic", tfjsonpath.New("name"), knownvalue.StringExact("Example Connector")),
+						statecheck.ExpectKnownValue("data.stack_payments_connector.generic", tfjsonpath.New("connector_provider"), knownvalue.StringExact("Generic")),
+						statecheck.ExpectKnownValue("data.stack_payments_connector_schedules.generic", tfjsonpath.New("schedules"), knownvalue.ListSizeExact(1)),
+						statecheck.ExpectKnownValue("data.stack_payments_connector_schedule_instances.generic", tfjsonpath.New("instances"), knownvalue.ListSizeExact(2)),
+						statecheck.ExpectKnownValue("data.stack_payments_connector_schedule_instances.generic", tfjsonpath.New("last_instance").AtMapKey("id"), knownvalue.StringExact("run-2")),
+						statecheck.ExpectKnownValue("data.stack_payments_connector_schedule_instances.generic", tfjsonpath.New("last_instance").AtMapKey("error"), knownvalue.StringExact("rate limited")),
+					},
+				},
+			},
+		})
+	})
+}
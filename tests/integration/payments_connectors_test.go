@@ -155,9 +155,28 @@ func TestPaymentsConnectors(t *testing.T) {
 			},
 		}, nil)
 
+		taskId := uuid.NewString()
 		paymentsSdk.EXPECT().DeleteConnector(gomock.Any(), operations.V3UninstallConnectorRequest{
 			ConnectorID: connectorId,
-		}).Return(nil, nil)
+		}).Return(&operations.V3UninstallConnectorResponse{
+			V3UninstallConnectorResponse: &shared.V3UninstallConnectorResponse{
+				Data: shared.V3UninstallConnectorResponseData{
+					TaskID: taskId,
+				},
+			},
+		}, nil)
+
+		paymentsSdk.EXPECT().GetTask(gomock.Any(), operations.V3GetTaskRequest{
+			TaskID: taskId,
+		}).Return(&operations.V3GetTaskResponse{
+			V3GetTaskResponse: &shared.V3GetTaskResponse{
+				Data: shared.V3Task{
+					ID:          taskId,
+					ConnectorID: pointer.For(connectorId),
+					Status:      shared.V3TaskStatusEnumSucceeded,
+				},
+			},
+		}, nil)
 
 		// testCases
 		resource.ParallelTest(t, resource.TestCase{
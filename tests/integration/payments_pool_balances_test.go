@@ -0,0 +1,141 @@
+package integration_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestPaymentsPoolBalancesDataSource(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "payments_pool_balances"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "payments",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+		poolId := uuid.NewString()
+		at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		paymentsSdk.EXPECT().GetPoolBalancesLatest(gomock.Any(), operations.V3GetPoolBalancesLatestRequest{
+			PoolID: poolId,
+		}).Return(&operations.V3GetPoolBalancesLatestResponse{
+			V3PoolBalancesResponse: &shared.V3PoolBalancesResponse{
+				Data: []shared.V3PoolBalance{
+					{Asset: "USD/2", Amount: big.NewInt(1000), RelatedAccounts: []string{"acc1"}},
+				},
+			},
+		}, nil).AnyTimes()
+
+		paymentsSdk.EXPECT().GetPoolBalances(gomock.Any(), operations.V3GetPoolBalancesRequest{
+			PoolID: poolId,
+			At:     &at,
+		}).Return(&operations.V3GetPoolBalancesResponse{
+			V3PoolBalancesResponse: &shared.V3PoolBalancesResponse{
+				Data: []shared.V3PoolBalance{
+					{Asset: "USD/2", Amount: big.NewInt(500), RelatedAccounts: []string{"acc1"}},
+				},
+			},
+		}, nil).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					data "stack_payments_pool_balances" "latest" {
+						pool_id = "` + poolId + `"
+					}
+
+					data "stack_payments_pool_balances" "at" {
+						pool_id = "` + poolId + `"
+						at = "2025-01-02T03:04:05Z"
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_payments_pool_balances.latest", tfjsonpath.New("balances"), knownvalue.MapExact(
+							map[string]knownvalue.Check{
+								"USD/2": knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"amount":           knownvalue.Int64Exact(1000),
+									"related_accounts": knownvalue.ListExact([]knownvalue.Check{knownvalue.StringExact("acc1")}),
+								}),
+							},
+						)),
+						statecheck.ExpectKnownValue("data.stack_payments_pool_balances.at", tfjsonpath.New("balances").AtMapKey("USD/2").AtMapKey("amount"), knownvalue.Int64Exact(500)),
+					},
+				},
+			},
+		})
+	})
+}
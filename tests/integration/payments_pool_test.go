@@ -1,15 +1,19 @@
 package integration_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	formance "github.com/formancehq/formance-sdk-go/v3"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/sdkerrors"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -32,6 +36,151 @@ import (
 	"github.com/formancehq/terraform-provider-stack/pkg"
 )
 
+func TestPaymentsPoolWithAccounts(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "payments_connectors"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		// Module and sdk expectations
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "payments",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+		poolId := uuid.NewString()
+		connectorId := uuid.NewString()
+		accounts := []shared.V3Account{
+			{ID: "account1", ConnectorID: connectorId, Reference: "main"},
+			{ID: "account2", ConnectorID: connectorId, Reference: "reserve"},
+		}
+
+		// Accounts are resolved by connector and reference
+		paymentsSdk.EXPECT().ListAccounts(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req operations.V3ListAccountsRequest) (*operations.V3ListAccountsResponse, error) {
+			data, err := json.Marshal(req.Query)
+			require.NoError(t, err)
+			found := []shared.V3Account{}
+			for _, account := range accounts {
+				if strings.Contains(string(data), `"reference":"`+account.Reference+`"`) {
+					found = append(found, account)
+				}
+			}
+			return &operations.V3ListAccountsResponse{
+				V3AccountsCursorResponse: &shared.V3AccountsCursorResponse{
+					Cursor: shared.V3AccountsCursorResponseCursor{
+						Data: found,
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		paymentsSdk.EXPECT().CreatePool(gomock.Any(), gomock.Cond(func(req *shared.V3CreatePoolRequest) bool {
+			return req.Name == "Example Pool" && len(req.AccountIDs) == 2
+		})).Return(&operations.V3CreatePoolResponse{
+			V3CreatePoolResponse: &shared.V3CreatePoolResponse{
+				Data: poolId,
+			},
+		}, nil)
+
+		// The API returns the accounts in a different order than the configuration
+		paymentsSdk.EXPECT().GetPool(gomock.Any(), operations.V3GetPoolRequest{
+			PoolID: poolId,
+		}).Return(&operations.V3GetPoolResponse{
+			V3GetPoolResponse: &shared.V3GetPoolResponse{
+				Data: shared.V3Pool{
+					ID:           poolId,
+					Name:         "Example Pool",
+					PoolAccounts: []string{"account2", "account1"},
+					CreatedAt:    time.Now(),
+				},
+			},
+		}, nil).AnyTimes()
+
+		paymentsSdk.EXPECT().DeletePool(gomock.Any(), operations.V3DeletePoolRequest{
+			PoolID: poolId,
+		}).Return(nil, nil)
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					resource "stack_payments_pool" "default" {
+						name = "Example Pool"
+						accounts = [
+							{
+								connector_id = "` + connectorId + `"
+								reference = "main"
+							},
+							{
+								connector_id = "` + connectorId + `"
+								reference = "reserve"
+							},
+						]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							"stack_payments_pool.default",
+							tfjsonpath.New("accounts_ids"),
+							knownvalue.SetExact(
+								[]knownvalue.Check{
+									knownvalue.StringExact("account1"),
+									knownvalue.StringExact("account2"),
+								},
+							),
+						),
+					},
+				},
+			},
+		})
+	})
+}
+
 func TestPaymentsPoolWithAccountIds(t *testing.T) {
 	t.Parallel()
 	t.Run(t.Name(), func(t *testing.T) {
@@ -77,6 +226,15 @@ func TestPaymentsPoolWithAccountIds(t *testing.T) {
 		}, nil).AnyTimes()
 		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
 
+		// The accounts are checked when planning
+		paymentsSdk.EXPECT().GetAccount(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, req operations.V3GetAccountRequest) (*operations.V3GetAccountResponse, error) {
+			return &operations.V3GetAccountResponse{
+				V3GetAccountResponse: &shared.V3GetAccountResponse{
+					Data: shared.V3Account{ID: req.AccountID},
+				},
+			}, nil
+		}).AnyTimes()
+
 		poolId := uuid.NewString()
 		firstPool := shared.V3Pool{
 			ID:           poolId,
@@ -169,7 +327,7 @@ func TestPaymentsPoolWithAccountIds(t *testing.T) {
 						statecheck.ExpectKnownValue(
 							"stack_payments_pool.default",
 							tfjsonpath.New("accounts_ids"),
-							knownvalue.ListExact(
+							knownvalue.SetExact(
 								[]knownvalue.Check{
 									knownvalue.StringExact("account1"),
 									knownvalue.StringExact("account2"),
@@ -197,7 +355,7 @@ func TestPaymentsPoolWithAccountIds(t *testing.T) {
 						statecheck.ExpectKnownValue(
 							"stack_payments_pool.default",
 							tfjsonpath.New("accounts_ids"),
-							knownvalue.ListExact(
+							knownvalue.SetExact(
 								[]knownvalue.Check{
 									knownvalue.StringExact("account1"),
 								},
@@ -327,30 +485,25 @@ func TestPaymentsPoolWithQuery(t *testing.T) {
 			},
 		}, nil)
 
-		// Refresh state creation and update
+		// Refresh state, the pool query follows the updates
 		paymentsSdk.EXPECT().GetPool(gomock.Any(), operations.V3GetPoolRequest{
 			PoolID: poolId,
-		}).Return(&operations.V3GetPoolResponse{
-			V3GetPoolResponse: &shared.V3GetPoolResponse{
-				Data: firstPool,
-			},
-		}, nil)
-
-		// paymentsSdk.EXPECT().UpdatePool(gomock.Any(), gomock.Cond(func(op operations.V3UpdatePoolQueryRequest) bool {
-		// 	return strings.ReplaceAll(op.PoolID, "\"", "") == poolId && fmt.Sprintf("%v", op.V3UpdatePoolQueryRequest.Query) == fmt.Sprintf("%v", queryUpdatedAsMap)
-		// })).Return(&operations.V3UpdatePoolQueryResponse{
-		// 	StatusCode: 200,
-		// }, nil)
-
-		// // refresh state deletion
-		// firstPool.Query = queryUpdatedAsMap
-		// paymentsSdk.EXPECT().GetPool(gomock.Any(), operations.V3GetPoolRequest{
-		// 	PoolID: poolId,
-		// }).Return(&operations.V3GetPoolResponse{
-		// 	V3GetPoolResponse: &shared.V3GetPoolResponse{
-		// 		Data: firstPool,
-		// 	},
-		// }, nil).Times(1)
+		}).DoAndReturn(func(_ context.Context, _ operations.V3GetPoolRequest) (*operations.V3GetPoolResponse, error) {
+			return &operations.V3GetPoolResponse{
+				V3GetPoolResponse: &shared.V3GetPoolResponse{
+					Data: firstPool,
+				},
+			}, nil
+		}).AnyTimes()
+
+		paymentsSdk.EXPECT().UpdatePool(gomock.Any(), gomock.Cond(func(op operations.V3UpdatePoolQueryRequest) bool {
+			return op.PoolID == poolId && reflect.DeepEqual(op.V3UpdatePoolQueryRequest.Query, queryUpdatedAsMap)
+		})).DoAndReturn(func(_ context.Context, op operations.V3UpdatePoolQueryRequest) (*operations.V3UpdatePoolQueryResponse, error) {
+			firstPool.Query = queryUpdatedAsMap
+			return &operations.V3UpdatePoolQueryResponse{
+				StatusCode: 200,
+			}, nil
+		})
 
 		paymentsSdk.EXPECT().DeletePool(gomock.Any(), operations.V3DeletePoolRequest{
 			PoolID: poolId,
@@ -389,43 +542,29 @@ func TestPaymentsPoolWithQuery(t *testing.T) {
 							tfjsonpath.New("name"),
 							knownvalue.StringExact("Example Pool"),
 						),
-						// statecheck.ExpectKnownValue(
-						// 	"stack_payments_pool.default",
-						// 	tfjsonpath.New("accounts_ids"),
-						// 	knownvalue.ListExact(
-						// 		[]knownvalue.Check{
-						// 			knownvalue.StringExact("account1"),
-						// 			knownvalue.StringExact("account2"),
-						// 		},
-						// 	),
-						// ),
 					},
 				},
-				// {
-				// 	Config: `
-				// 		provider "stack" {
-				// 			stack_id = "` + stackId + `"
-				// 			organization_id = "` + organizationId + `"
-				// 			uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
-				// 		}
-
-				// 		resource "stack_payments_pool" "default" {
-				// 			name = "Example Pool"
-				// 			query = ` + queryUpdated + `
-				// 		}
-				// 	`,
-				// 	ConfigStateChecks: []statecheck.StateCheck{
-				// 		// statecheck.ExpectKnownValue(
-				// 		// 	"stack_payments_pool.default",
-				// 		// 	tfjsonpath.New("accounts_ids"),
-				// 		// 	knownvalue.ListExact(
-				// 		// 		[]knownvalue.Check{
-				// 		// 			knownvalue.StringExact("account1"),
-				// 		// 		},
-				// 		// 	),
-				// 		// ),
-				// 	},
-				// },
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					resource "stack_payments_pool" "default" {
+						name = "Example Pool"
+						query = ` + queryUpdated + `
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue(
+							"stack_payments_pool.default",
+							tfjsonpath.New("id"),
+							knownvalue.StringExact(poolId),
+						),
+					},
+				},
 			},
 		})
 
@@ -521,3 +660,89 @@ func TestPaymentsPoolWithConflict(t *testing.T) {
 		})
 	})
 }
+
+func TestPaymentsPoolWithUnknownAccount(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "payments_pool"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "payments",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+		paymentsSdk.EXPECT().GetAccount(gomock.Any(), operations.V3GetAccountRequest{
+			AccountID: "account1",
+		}).Return(&operations.V3GetAccountResponse{
+			V3GetAccountResponse: &shared.V3GetAccountResponse{
+				Data: shared.V3Account{ID: "account1"},
+			},
+		}, nil).AnyTimes()
+		paymentsSdk.EXPECT().GetAccount(gomock.Any(), operations.V3GetAccountRequest{
+			AccountID: "unknown",
+		}).Return(nil, sdkerrors.NewSDKError("API error occurred", http.StatusNotFound, `{"errorCode":"NOT_FOUND","errorMessage":"account not found"}`, nil)).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					resource "stack_payments_pool" "default" {
+						name = "Example Pool"
+						accounts_ids = [
+							"account1",
+							"unknown",
+						]
+					}
+				`,
+					ExpectError: regexp.MustCompile("Account Not Found"),
+				},
+			},
+		})
+	})
+}
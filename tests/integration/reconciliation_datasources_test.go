@@ -0,0 +1,198 @@
+package integration_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestReconciliationDataSources(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		reconciliationSdk := sdk.NewMockReconciliationSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "reconciliation_datasources"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "reconciliation",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Reconciliation().Return(reconciliationSdk).AnyTimes()
+
+		policyId := uuid.NewString()
+		reconciliationId := uuid.NewString()
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		reconciliation := shared.Reconciliation{
+			ID:                   reconciliationId,
+			PolicyID:             policyId,
+			Status:               "OK",
+			CreatedAt:            createdAt.Add(time.Hour),
+			ReconciledAtLedger:   createdAt,
+			ReconciledAtPayments: createdAt,
+			LedgerBalances:       map[string]*big.Int{"USD/2": big.NewInt(1000)},
+			PaymentsBalances:     map[string]*big.Int{"USD/2": big.NewInt(1000)},
+			DriftBalances:        map[string]*big.Int{"USD/2": big.NewInt(0)},
+		}
+
+		// The listing spans two pages to go through the cursor
+		reconciliationSdk.EXPECT().ListPolicies(gomock.Any(), operations.ListPoliciesRequest{}).Return(&operations.ListPoliciesResponse{
+			PoliciesCursorResponse: &shared.PoliciesCursorResponse{
+				Cursor: shared.PoliciesCursorResponseCursor{
+					Data: []shared.Policy{
+						{ID: uuid.NewString(), Name: "weekly", LedgerName: "main", PaymentsPoolID: "pool", CreatedAt: createdAt},
+					},
+					HasMore: true,
+					Next:    pointer.For("next"),
+				},
+			},
+		}, nil).AnyTimes()
+		reconciliationSdk.EXPECT().ListPolicies(gomock.Any(), operations.ListPoliciesRequest{
+			Cursor: pointer.For("next"),
+		}).Return(&operations.ListPoliciesResponse{
+			PoliciesCursorResponse: &shared.PoliciesCursorResponse{
+				Cursor: shared.PoliciesCursorResponseCursor{
+					Data: []shared.Policy{
+						{ID: policyId, Name: "daily", LedgerName: "main", PaymentsPoolID: "pool", CreatedAt: createdAt},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		reconciliationSdk.EXPECT().ListReconciliations(gomock.Any(), operations.ListReconciliationsRequest{
+			PageSize: pointer.For(int64(20)),
+		}).Return(&operations.ListReconciliationsResponse{
+			ReconciliationsCursorResponse: &shared.ReconciliationsCursorResponse{
+				Cursor: shared.ReconciliationsCursorResponseCursor{
+					Data: []shared.Reconciliation{
+						{ID: uuid.NewString(), PolicyID: policyId, Status: "NOT_OK", CreatedAt: createdAt, ReconciledAtLedger: createdAt, ReconciledAtPayments: createdAt},
+						reconciliation,
+						{ID: uuid.NewString(), PolicyID: uuid.NewString(), Status: "OK", CreatedAt: createdAt.Add(2 * time.Hour), ReconciledAtLedger: createdAt, ReconciledAtPayments: createdAt},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		reconciliationSdk.EXPECT().GetReconciliation(gomock.Any(), operations.GetReconciliationRequest{
+			ReconciliationID: reconciliationId,
+		}).Return(&operations.GetReconciliationResponse{
+			ReconciliationResponse: &shared.ReconciliationResponse{
+				Data: reconciliation,
+			},
+		}, nil).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					data "stack_reconciliation_policies" "daily" {
+						name = "daily"
+						ledger_name = "main"
+					}
+
+					data "stack_reconciliations" "daily" {
+						policy_id = data.stack_reconciliation_policies.daily.policies[0].id
+					}
+
+					data "stack_reconciliation" "last" {
+						id = data.stack_reconciliations.daily.last_reconciliation.id
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_reconciliation_policies.daily", tfjsonpath.New("policies"), knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"id":               knownvalue.StringExact(policyId),
+									"name":             knownvalue.StringExact("daily"),
+									"ledger_name":      knownvalue.StringExact("main"),
+									"payments_pool_id": knownvalue.StringExact("pool"),
+									"ledger_query":     knownvalue.Null(),
+									"created_at":       knownvalue.StringExact("2025-01-02T03:04:05Z"),
+								}),
+							},
+						)),
+						statecheck.ExpectKnownValue("data.stack_reconciliations.daily", tfjsonpath.New("reconciliations"), knownvalue.ListSizeExact(2)),
+						statecheck.ExpectKnownValue("data.stack_reconciliations.daily", tfjsonpath.New("last_reconciliation").AtMapKey("id"), knownvalue.StringExact(reconciliationId)),
+						statecheck.ExpectKnownValue("data.stack_reconciliation.last", tfjsonpath.New("status"), knownvalue.StringExact("OK")),
+						statecheck.ExpectKnownValue("data.stack_reconciliation.last", tfjsonpath.New("ledger_balances"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(1000),
+						})),
+						statecheck.ExpectKnownValue("data.stack_reconciliation.last", tfjsonpath.New("payments_balances"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(1000),
+						})),
+						statecheck.ExpectKnownValue("data.stack_reconciliation.last", tfjsonpath.New("drift"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(0),
+						})),
+					},
+				},
+			},
+		})
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
 	formance "github.com/formancehq/formance-sdk-go/v3"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/sdkerrors"
 	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -40,6 +42,8 @@ func TestReconciliationPolicy(t *testing.T) {
 		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
 		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
 		reconciliationSdk := sdk.NewMockReconciliationSdkImpl(ctrl)
+		ledgerSdk := sdk.NewMockLedgerSdkImpl(ctrl)
+		paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
 		stackId := uuid.NewString()
 		organizationId := uuid.NewString()
 
@@ -70,10 +74,38 @@ func TestReconciliationPolicy(t *testing.T) {
 						Version: "develop",
 						Health:  true,
 					},
+					{
+						Name:    "ledger",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "payments",
+						Version: "develop",
+						Health:  true,
+					},
 				},
 			},
 		}, nil).AnyTimes()
 		stacksdk.EXPECT().Reconciliation().Return(reconciliationSdk).AnyTimes()
+		stacksdk.EXPECT().Ledger().Return(ledgerSdk).AnyTimes()
+		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+		// The referenced ledger and pool are checked when planning
+		ledgerSdk.EXPECT().GetLedger(gomock.Any(), operations.V2GetLedgerRequest{
+			Ledger: "test-ledger",
+		}).Return(&operations.V2GetLedgerResponse{
+			V2GetLedgerResponse: &shared.V2GetLedgerResponse{
+				Data: shared.V2Ledger{Name: "test-ledger"},
+			},
+		}, nil).AnyTimes()
+		paymentsSdk.EXPECT().GetPool(gomock.Any(), operations.V3GetPoolRequest{
+			PoolID: "test-payments-pool",
+		}).Return(&operations.V3GetPoolResponse{
+			V3GetPoolResponse: &shared.V3GetPoolResponse{
+				Data: shared.V3Pool{ID: "test-payments-pool"},
+			},
+		}, nil).AnyTimes()
 
 		qry := `{
 		"$and": [
@@ -220,3 +252,96 @@ func TestReconciliationPolicy(t *testing.T) {
 		})
 	})
 }
+
+func TestReconciliationPolicyWithUnknownReferences(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		ledgerSdk := sdk.NewMockLedgerSdkImpl(ctrl)
+		paymentsSdk := sdk.NewMockPaymentsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "reconciliation_policy"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "reconciliation",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "ledger",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "payments",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Ledger().Return(ledgerSdk).AnyTimes()
+		stacksdk.EXPECT().Payments().Return(paymentsSdk).AnyTimes()
+
+		ledgerSdk.EXPECT().GetLedger(gomock.Any(), operations.V2GetLedgerRequest{
+			Ledger: "missing-ledger",
+		}).Return(nil, sdkerrors.NewSDKError("API error occurred", http.StatusNotFound, `{"errorCode":"NOT_FOUND","errorMessage":"ledger not found"}`, nil)).AnyTimes()
+		paymentsSdk.EXPECT().GetPool(gomock.Any(), operations.V3GetPoolRequest{
+			PoolID: "missing-pool",
+		}).Return(nil, sdkerrors.NewSDKError("API error occurred", http.StatusNotFound, `{"errorCode":"NOT_FOUND","errorMessage":"pool not found"}`, nil)).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					resource "stack_reconciliation_policy" "policy" {
+						ledger_name = "missing-ledger"
+						name = "Test Policy"
+						payments_pool_id = "missing-pool"
+						ledger_query = {}
+					}
+				`,
+					ExpectError: regexp.MustCompile("(?s)Ledger Not Found.*Payments Pool Not Found"),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,157 @@
+package integration_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestReconciliation(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		reconciliationSdk := sdk.NewMockReconciliationSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "reconciliation"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "reconciliation",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Reconciliation().Return(reconciliationSdk).AnyTimes()
+
+		policyId := uuid.NewString()
+		reconciliationId := uuid.NewString()
+		reconciliation := shared.Reconciliation{
+			ID:                   reconciliationId,
+			PolicyID:             policyId,
+			CreatedAt:            time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+			ReconciledAtLedger:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			ReconciledAtPayments: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+			Status:               "NOT_OK",
+			DriftBalances: map[string]*big.Int{
+				"USD/2": big.NewInt(100),
+			},
+			LedgerBalances: map[string]*big.Int{
+				"USD/2": big.NewInt(1100),
+			},
+			PaymentsBalances: map[string]*big.Int{
+				"USD/2": big.NewInt(1000),
+			},
+		}
+
+		// Init state
+		reconciliationSdk.EXPECT().Reconcile(gomock.Any(), operations.ReconcileRequest{
+			PolicyID: policyId,
+			ReconciliationRequest: shared.ReconciliationRequest{
+				ReconciledAtLedger:   reconciliation.ReconciledAtLedger,
+				ReconciledAtPayments: reconciliation.ReconciledAtPayments,
+			},
+		}).Return(&operations.ReconcileResponse{
+			ReconciliationResponse: &shared.ReconciliationResponse{
+				Data: reconciliation,
+			},
+		}, nil)
+
+		// refresh state deletion
+		reconciliationSdk.EXPECT().GetReconciliation(gomock.Any(), operations.GetReconciliationRequest{
+			ReconciliationID: reconciliationId,
+		}).Return(&operations.GetReconciliationResponse{
+			ReconciliationResponse: &shared.ReconciliationResponse{
+				Data: reconciliation,
+			},
+		}, nil)
+
+		// testCases
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+
+					resource "stack_reconciliation" "run" {
+						policy_id = "` + policyId + `"
+						reconciled_at_ledger = "2025-01-01T00:00:00Z"
+						reconciled_at_payments = "2025-01-02T00:00:00Z"
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_reconciliation.run", tfjsonpath.New("id"), knownvalue.StringExact(reconciliationId)),
+						statecheck.ExpectKnownValue("stack_reconciliation.run", tfjsonpath.New("status"), knownvalue.StringExact("NOT_OK")),
+						statecheck.ExpectKnownValue("stack_reconciliation.run", tfjsonpath.New("error"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("stack_reconciliation.run", tfjsonpath.New("created_at"), knownvalue.StringExact("2025-01-03T00:00:00Z")),
+						statecheck.ExpectKnownValue("stack_reconciliation.run", tfjsonpath.New("drift"), knownvalue.MapExact(
+							map[string]knownvalue.Check{
+								"USD/2": knownvalue.Int64Exact(100),
+							},
+						)),
+					},
+				},
+			},
+		})
+	})
+}
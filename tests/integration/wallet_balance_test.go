@@ -0,0 +1,209 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"testing"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestWalletBalance(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		walletsSdk := sdk.NewMockWalletsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "wallet_balance"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "wallets",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Wallets().Return(walletsSdk).AnyTimes()
+
+		walletID := uuid.NewString()
+
+		// The balances of the wallet as stored by the server
+		balances := map[string]shared.BalanceWithAssets{}
+
+		walletsSdk.EXPECT().ListBalances(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.ListBalancesRequest, _ ...operations.Option) (*operations.ListBalancesResponse, error) {
+			data := []shared.Balance{{Name: "main"}}
+			for _, balance := range balances {
+				data = append(data, shared.Balance{
+					Name:      balance.Name,
+					ExpiresAt: balance.ExpiresAt,
+					Priority:  balance.Priority,
+				})
+			}
+			return &operations.ListBalancesResponse{
+				ListBalancesResponse: &shared.ListBalancesResponse{
+					Cursor: shared.ListBalancesResponseCursor{
+						Data: data,
+					},
+				},
+			}, nil
+		}).Times(1)
+
+		walletsSdk.EXPECT().CreateBalance(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.CreateBalanceRequest, _ ...operations.Option) (*operations.CreateBalanceResponse, error) {
+			require.Equal(t, walletID, request.ID)
+			balance := request.CreateBalanceRequest
+			// The server returns a zero priority when none is set
+			priority := balance.Priority
+			if priority == nil {
+				priority = big.NewInt(0)
+			}
+			balances[balance.Name] = shared.BalanceWithAssets{
+				Name:      balance.Name,
+				ExpiresAt: balance.ExpiresAt,
+				Priority:  priority,
+				Assets:    map[string]*big.Int{"USD/2": big.NewInt(0)},
+			}
+			return &operations.CreateBalanceResponse{
+				CreateBalanceResponse: &shared.CreateBalanceResponse{
+					Data: *balance,
+				},
+			}, nil
+		}).Times(1)
+
+		walletsSdk.EXPECT().GetBalance(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.GetBalanceRequest, _ ...operations.Option) (*operations.GetBalanceResponse, error) {
+			require.Equal(t, walletID, request.ID)
+			return &operations.GetBalanceResponse{
+				GetBalanceResponse: &shared.GetBalanceResponse{
+					Data: balances[request.BalanceName],
+				},
+			}, nil
+		}).AnyTimes()
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		balanceConfig := func(expiresAt string) string {
+			return providerConfig + `
+			resource "stack_wallet_balance" "promo" {
+				wallet_id  = "` + walletID + `"
+				name       = "promo-2026"
+				expires_at = "` + expiresAt + `"
+			}
+			`
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config:      balanceConfig("2026-12-31"),
+					ExpectError: regexp.MustCompile("Invalid Date"),
+				},
+				{
+					Config: balanceConfig("2026-12-31T23:59:59Z"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_wallet_balance.promo", tfjsonpath.New("id"), knownvalue.StringExact(walletID+"/promo-2026")),
+						statecheck.ExpectKnownValue("stack_wallet_balance.promo", tfjsonpath.New("priority"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("stack_wallet_balance.promo", tfjsonpath.New("assets"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(0),
+						})),
+					},
+				},
+				{
+					// The amounts moved by the applications are read back
+					PreConfig: func() {
+						balances["promo-2026"].Assets["USD/2"] = big.NewInt(2500)
+					},
+					Config: balanceConfig("2026-12-31T23:59:59Z"),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_wallet_balance.promo", tfjsonpath.New("assets"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(2500),
+						})),
+					},
+				},
+				{
+					Config:      balanceConfig("2027-12-31T23:59:59Z"),
+					ExpectError: regexp.MustCompile("Wallet Balance Cannot Be Updated"),
+				},
+				{
+					// A renamed balance is replaced, it can have another expiry date
+					Config: providerConfig + `
+					resource "stack_wallet_balance" "promo" {
+						wallet_id  = "` + walletID + `"
+						name       = "promo-2027"
+						expires_at = "2027-12-31T23:59:59Z"
+					}
+					`,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					ResourceName:      "stack_wallet_balance.promo",
+					ImportState:       true,
+					ImportStateId:     walletID + "/promo-2026",
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
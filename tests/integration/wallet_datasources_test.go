@@ -0,0 +1,193 @@
+package integration_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestWalletDataSources(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		walletsSdk := sdk.NewMockWalletsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "wallet_datasources"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "wallets",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Wallets().Return(walletsSdk).AnyTimes()
+
+		createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		wallet := shared.Wallet{
+			ID:        uuid.NewString(),
+			Name:      "default",
+			Ledger:    "wallets-001",
+			Metadata:  map[string]string{"customer": "42"},
+			CreatedAt: createdAt,
+		}
+
+		// The listing spans two pages to go through the cursor, the filters are only sent with the first page
+		walletsSdk.EXPECT().ListWallets(gomock.Any(), operations.ListWalletsRequest{
+			Name:     pointer.For("default"),
+			Metadata: map[string]string{"customer": "42"},
+		}).Return(&operations.ListWalletsResponse{
+			ListWalletsResponse: &shared.ListWalletsResponse{
+				Cursor: shared.ListWalletsResponseCursor{
+					HasMore: pointer.For(true),
+					Next:    pointer.For("next"),
+				},
+			},
+		}, nil).AnyTimes()
+		walletsSdk.EXPECT().ListWallets(gomock.Any(), operations.ListWalletsRequest{
+			Cursor: pointer.For("next"),
+		}).Return(&operations.ListWalletsResponse{
+			ListWalletsResponse: &shared.ListWalletsResponse{
+				Cursor: shared.ListWalletsResponseCursor{
+					Data: []shared.Wallet{wallet},
+				},
+			},
+		}, nil).AnyTimes()
+		walletsSdk.EXPECT().GetWalletSummary(gomock.Any(), operations.GetWalletSummaryRequest{
+			ID: wallet.ID,
+		}).Return(&operations.GetWalletSummaryResponse{
+			GetWalletSummaryResponse: &shared.GetWalletSummaryResponse{
+				Data: shared.WalletSummary{
+					AvailableFunds: map[string]*big.Int{"USD/2": big.NewInt(1500)},
+					HoldFunds:      map[string]*big.Int{"USD/2": big.NewInt(200)},
+					ExpiredFunds:   map[string]*big.Int{"USD/2": big.NewInt(300)},
+					ExpirableFunds: map[string]*big.Int{},
+					Balances: []shared.BalanceWithAssets{
+						{
+							Name:   "main",
+							Assets: map[string]*big.Int{"USD/2": big.NewInt(1500)},
+						},
+						{
+							Name:      "promo-2024",
+							ExpiresAt: pointer.For(createdAt),
+							Priority:  big.NewInt(10),
+							Assets:    map[string]*big.Int{"USD/2": big.NewInt(300)},
+						},
+					},
+				},
+			},
+		}, nil).AnyTimes()
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: `
+					provider "stack" {
+						stack_id = "` + stackId + `"
+						organization_id = "` + organizationId + `"
+						uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+					}
+					data "stack_wallets" "customer" {
+						name     = "default"
+						metadata = { customer = "42" }
+					}
+					data "stack_wallet_summary" "customer" {
+						id = data.stack_wallets.customer.wallets[0].id
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_wallets.customer", tfjsonpath.New("wallets"), knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"id":     knownvalue.StringExact(wallet.ID),
+									"name":   knownvalue.StringExact("default"),
+									"ledger": knownvalue.StringExact("wallets-001"),
+									"metadata": knownvalue.MapExact(map[string]knownvalue.Check{
+										"customer": knownvalue.StringExact("42"),
+									}),
+									"created_at": knownvalue.StringExact("2025-01-02T03:04:05Z"),
+								}),
+							},
+						)),
+						statecheck.ExpectKnownValue("data.stack_wallet_summary.customer", tfjsonpath.New("available_funds"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(1500),
+						})),
+						statecheck.ExpectKnownValue("data.stack_wallet_summary.customer", tfjsonpath.New("hold_funds"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(200),
+						})),
+						statecheck.ExpectKnownValue("data.stack_wallet_summary.customer", tfjsonpath.New("expired_funds"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"USD/2": knownvalue.Int64Exact(300),
+						})),
+						statecheck.ExpectKnownValue("data.stack_wallet_summary.customer", tfjsonpath.New("expirable_funds"), knownvalue.MapSizeExact(0)),
+						statecheck.ExpectKnownValue("data.stack_wallet_summary.customer", tfjsonpath.New("balances").AtSliceIndex(1), knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":       knownvalue.StringExact("promo-2024"),
+							"expires_at": knownvalue.StringExact("2025-01-02T03:04:05Z"),
+							"priority":   knownvalue.Int64Exact(10),
+							"assets": knownvalue.MapExact(map[string]knownvalue.Check{
+								"USD/2": knownvalue.Int64Exact(300),
+							}),
+						})),
+					},
+				},
+			},
+		})
+	})
+}
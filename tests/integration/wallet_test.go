@@ -0,0 +1,191 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestWallet(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		walletsSdk := sdk.NewMockWalletsSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "wallet"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "wallets",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Wallets().Return(walletsSdk).AnyTimes()
+
+		// The wallets as stored by the server
+		wallets := map[string]shared.WalletWithBalances{}
+
+		walletsSdk.EXPECT().CreateWallet(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.CreateWalletRequest, _ ...operations.Option) (*operations.CreateWalletResponse, error) {
+			wallet := shared.WalletWithBalances{
+				ID:        uuid.NewString(),
+				Name:      request.CreateWalletRequest.Name,
+				Ledger:    "wallets-001",
+				Metadata:  request.CreateWalletRequest.Metadata,
+				CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			}
+			wallets[wallet.ID] = wallet
+			return &operations.CreateWalletResponse{
+				CreateWalletResponse: &shared.CreateWalletResponse{
+					Data: shared.Wallet{
+						ID:        wallet.ID,
+						Name:      wallet.Name,
+						Ledger:    wallet.Ledger,
+						Metadata:  wallet.Metadata,
+						CreatedAt: wallet.CreatedAt,
+					},
+				},
+			}, nil
+		}).Times(1)
+
+		walletsSdk.EXPECT().GetWallet(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.GetWalletRequest, _ ...operations.Option) (*operations.GetWalletResponse, error) {
+			return &operations.GetWalletResponse{
+				ActivityGetWalletOutput: &shared.ActivityGetWalletOutput{
+					Data: wallets[request.ID],
+				},
+			}, nil
+		}).AnyTimes()
+
+		// The metadata is merged with the existing one
+		walletsSdk.EXPECT().UpdateWallet(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.UpdateWalletRequest, _ ...operations.Option) (*operations.UpdateWalletResponse, error) {
+			wallet := wallets[request.ID]
+			for key, value := range request.RequestBody.Metadata {
+				wallet.Metadata[key] = value
+			}
+			wallets[request.ID] = wallet
+			return &operations.UpdateWalletResponse{}, nil
+		}).Times(1)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		walletConfig := func(metadata string) string {
+			return providerConfig + `
+			resource "stack_wallet" "fees" {
+				name     = "fees"
+				metadata = ` + metadata + `
+			}
+			`
+		}
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: walletConfig(`{ purpose = "fees" }`),
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_wallet.fees", tfjsonpath.New("ledger"), knownvalue.StringExact("wallets-001")),
+						statecheck.ExpectKnownValue("stack_wallet.fees", tfjsonpath.New("created_at"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+					},
+				},
+				{
+					Config: walletConfig(`{ purpose = "fees", owner = "finance" }`),
+					ConfigPlanChecks: resource.ConfigPlanChecks{
+						PreApply: []plancheck.PlanCheck{
+							plancheck.ExpectResourceAction("stack_wallet.fees", plancheck.ResourceActionUpdate),
+						},
+					},
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_wallet.fees", tfjsonpath.New("metadata"), knownvalue.MapExact(map[string]knownvalue.Check{
+							"purpose": knownvalue.StringExact("fees"),
+							"owner":   knownvalue.StringExact("finance"),
+						})),
+					},
+				},
+				{
+					Config:      walletConfig(`{ purpose = "fees" }`),
+					ExpectError: regexp.MustCompile("Wallet Metadata Cannot Be Removed"),
+				},
+				{
+					// A renamed wallet is replaced, its metadata can be removed
+					Config: providerConfig + `
+					resource "stack_wallet" "fees" {
+						name     = "fees-v2"
+						metadata = { purpose = "fees" }
+					}
+					`,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					ResourceName:      "stack_wallet.fees",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
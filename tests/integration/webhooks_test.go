@@ -0,0 +1,847 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	formance "github.com/formancehq/formance-sdk-go/v3"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/operations"
+	"github.com/formancehq/formance-sdk-go/v3/pkg/models/shared"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"go.opentelemetry.io/otel"
+
+	"github.com/formancehq/go-libs/v3/logging"
+	"github.com/formancehq/go-libs/v3/pointer"
+	cloudpkg "github.com/formancehq/terraform-provider-cloud/pkg"
+	"github.com/formancehq/terraform-provider-cloud/pkg/testprovider"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/formancehq/terraform-provider-stack/internal/server"
+	"github.com/formancehq/terraform-provider-stack/internal/server/sdk"
+	"github.com/formancehq/terraform-provider-stack/pkg"
+)
+
+func TestWebhooksActivation(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		webhooksSdk := sdk.NewMockWebhooksSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "webhooks"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "webhooks",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "ledger",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Webhooks().Return(webhooksSdk).AnyTimes()
+
+		// The webhook configuration as stored by the server
+		config := shared.WebhooksConfig{
+			ID:         uuid.NewString(),
+			Endpoint:   "https://example.com/webhooks",
+			EventTypes: []string{"ledger.committed_transactions"},
+			Secret:     "generated-secret",
+			Active:     true,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+
+		webhooksSdk.EXPECT().InsertConfig(gomock.Any(), shared.ConfigUser{
+			Endpoint:   config.Endpoint,
+			EventTypes: config.EventTypes,
+		}).Return(&operations.InsertConfigResponse{
+			ConfigResponse: &shared.ConfigResponse{
+				Data: config,
+			},
+		}, nil)
+
+		webhooksSdk.EXPECT().GetManyConfigs(gomock.Any(), operations.GetManyConfigsRequest{
+			ID: &config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.GetManyConfigsRequest) (*operations.GetManyConfigsResponse, error) {
+			return &operations.GetManyConfigsResponse{
+				ConfigsResponse: &shared.ConfigsResponse{
+					Cursor: shared.ConfigsResponseCursor{
+						Data: []shared.WebhooksConfig{config},
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		webhooksSdk.EXPECT().ActivateConfig(gomock.Any(), operations.ActivateConfigRequest{
+			ID: config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.ActivateConfigRequest, _ ...operations.Option) (*operations.ActivateConfigResponse, error) {
+			config.Active = true
+			return &operations.ActivateConfigResponse{
+				ConfigResponse: &shared.ConfigResponse{
+					Data: config,
+				},
+			}, nil
+		})
+
+		webhooksSdk.EXPECT().DeactivateConfig(gomock.Any(), operations.DeactivateConfigRequest{
+			ID: config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.DeactivateConfigRequest, _ ...operations.Option) (*operations.DeactivateConfigResponse, error) {
+			config.Active = false
+			return &operations.DeactivateConfigResponse{
+				ConfigResponse: &shared.ConfigResponse{
+					Data: config,
+				},
+			}, nil
+		})
+
+		webhooksSdk.EXPECT().DeleteConfig(gomock.Any(), operations.DeleteConfigRequest{
+			ID: config.ID,
+		}).Return(nil, nil)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("id"), knownvalue.StringExact(config.ID)),
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("active"), knownvalue.Bool(true)),
+					},
+				},
+				{
+					// The server disabled the endpoint after repeated failures, the apply reactivates it
+					PreConfig: func() {
+						config.Active = false
+					},
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("active"), knownvalue.Bool(true)),
+					},
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+						active = false
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("active"), knownvalue.Bool(false)),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestWebhooksSecret(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		webhooksSdk := sdk.NewMockWebhooksSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "webhooks"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "webhooks",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "ledger",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Webhooks().Return(webhooksSdk).AnyTimes()
+
+		// The webhook configuration as stored by the server
+		config := shared.WebhooksConfig{
+			ID:         uuid.NewString(),
+			Endpoint:   "https://example.com/webhooks",
+			EventTypes: []string{"ledger.committed_transactions"},
+			Secret:     "generated-secret",
+			Active:     true,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+
+		webhooksSdk.EXPECT().InsertConfig(gomock.Any(), shared.ConfigUser{
+			Endpoint:   config.Endpoint,
+			EventTypes: config.EventTypes,
+		}).Return(&operations.InsertConfigResponse{
+			ConfigResponse: &shared.ConfigResponse{
+				Data: config,
+			},
+		}, nil)
+
+		webhooksSdk.EXPECT().GetManyConfigs(gomock.Any(), operations.GetManyConfigsRequest{
+			ID: &config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.GetManyConfigsRequest) (*operations.GetManyConfigsResponse, error) {
+			return &operations.GetManyConfigsResponse{
+				ConfigsResponse: &shared.ConfigsResponse{
+					Cursor: shared.ConfigsResponseCursor{
+						Data: []shared.WebhooksConfig{config},
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		changeSecret := func(_ context.Context, req operations.ChangeConfigSecretRequest, _ ...operations.Option) (*operations.ChangeConfigSecretResponse, error) {
+			config.Secret = req.ConfigChangeSecret.Secret
+			return &operations.ChangeConfigSecretResponse{
+				ConfigResponse: &shared.ConfigResponse{
+					Data: config,
+				},
+			}, nil
+		}
+		gomock.InOrder(
+			webhooksSdk.EXPECT().ChangeConfigSecret(gomock.Any(), operations.ChangeConfigSecretRequest{
+				ID:                 config.ID,
+				ConfigChangeSecret: &shared.ConfigChangeSecret{Secret: "my-secret"},
+			}).DoAndReturn(changeSecret),
+			webhooksSdk.EXPECT().ChangeConfigSecret(gomock.Any(), operations.ChangeConfigSecretRequest{
+				ID:                 config.ID,
+				ConfigChangeSecret: &shared.ConfigChangeSecret{Secret: "write-only-secret"},
+			}).DoAndReturn(changeSecret),
+		)
+
+		webhooksSdk.EXPECT().DeleteConfig(gomock.Any(), operations.DeleteConfigRequest{
+			ID: config.ID,
+		}).Return(nil, nil)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("effective_secret"), knownvalue.StringExact("generated-secret")),
+					},
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+						secret = "my-secret"
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("effective_secret"), knownvalue.StringExact("my-secret")),
+					},
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+						secret_wo = "write-only-secret"
+						secret_version = 1
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("secret"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("secret_wo"), knownvalue.Null()),
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("effective_secret"), knownvalue.Null()),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestWebhooksEventTypes(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		webhooksSdk := sdk.NewMockWebhooksSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "webhooks"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "webhooks",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "ledger",
+						Version: "v2.2.0",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Webhooks().Return(webhooksSdk).AnyTimes()
+
+		// The webhook configuration as stored by the server, which lower-cases the event types
+		config := shared.WebhooksConfig{
+			ID:         uuid.NewString(),
+			Endpoint:   "https://example.com/webhooks",
+			EventTypes: []string{"ledger.committed_transactions", "ledger.saved_metadata"},
+			Secret:     "generated-secret",
+			Active:     true,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+
+		webhooksSdk.EXPECT().InsertConfig(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, user shared.ConfigUser) (*operations.InsertConfigResponse, error) {
+			require.Equal(t, config.Endpoint, user.Endpoint)
+			require.ElementsMatch(t, []string{"Ledger.Committed_Transactions", "ledger.saved_metadata"}, user.EventTypes)
+			return &operations.InsertConfigResponse{
+				ConfigResponse: &shared.ConfigResponse{
+					Data: config,
+				},
+			}, nil
+		})
+
+		webhooksSdk.EXPECT().GetManyConfigs(gomock.Any(), operations.GetManyConfigsRequest{
+			ID: &config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.GetManyConfigsRequest) (*operations.GetManyConfigsResponse, error) {
+			return &operations.GetManyConfigsResponse{
+				ConfigsResponse: &shared.ConfigsResponse{
+					Cursor: shared.ConfigsResponseCursor{
+						Data: []shared.WebhooksConfig{config},
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		webhooksSdk.EXPECT().DeleteConfig(gomock.Any(), operations.DeleteConfigRequest{
+			ID: config.ID,
+		}).Return(nil, nil)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					// Event types missing from the catalog are only reported as warnings, they do not block the plan
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.commited_transactions"]
+					}
+				`,
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.deleted_metadata", "ledger.committed_transactions", "LEDGER.COMMITTED_TRANSACTIONS"]
+					}
+				`,
+					ExpectError: regexp.MustCompile(`Duplicate Webhook Event Type`),
+				},
+				{
+					// The lower-cased event types returned by the API do not produce a diff
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["Ledger.Committed_Transactions", "ledger.saved_metadata"]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("event_types"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("Ledger.Committed_Transactions"),
+							knownvalue.StringExact("ledger.saved_metadata"),
+						})),
+					},
+				},
+				{
+					// Changing the case of an event type does not update the configuration
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.saved_metadata", "ledger.committed_transactions"]
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("event_types"), knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("ledger.committed_transactions"),
+							knownvalue.StringExact("ledger.saved_metadata"),
+						})),
+					},
+				},
+			},
+		})
+	})
+}
+
+func TestWebhooksVerification(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		webhooksSdk := sdk.NewMockWebhooksSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "webhooks"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "webhooks",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "ledger",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Webhooks().Return(webhooksSdk).AnyTimes()
+
+		// The webhook configuration as stored by the server
+		config := shared.WebhooksConfig{
+			ID:         uuid.NewString(),
+			Endpoint:   "https://example.com/webhooks",
+			EventTypes: []string{"ledger.committed_transactions"},
+			Secret:     "generated-secret",
+			Active:     true,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+		// The status answered by the endpoint to test events
+		statusCode := int64(http.StatusOK)
+
+		webhooksSdk.EXPECT().InsertConfig(gomock.Any(), shared.ConfigUser{
+			Endpoint:   config.Endpoint,
+			EventTypes: config.EventTypes,
+		}).Return(&operations.InsertConfigResponse{
+			ConfigResponse: &shared.ConfigResponse{
+				Data: config,
+			},
+		}, nil)
+
+		webhooksSdk.EXPECT().GetManyConfigs(gomock.Any(), operations.GetManyConfigsRequest{
+			ID: &config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.GetManyConfigsRequest) (*operations.GetManyConfigsResponse, error) {
+			return &operations.GetManyConfigsResponse{
+				ConfigsResponse: &shared.ConfigsResponse{
+					Cursor: shared.ConfigsResponseCursor{
+						Data: []shared.WebhooksConfig{config},
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		webhooksSdk.EXPECT().UpdateConfig(gomock.Any(), operations.UpdateConfigRequest{
+			ID: config.ID,
+			ConfigUser: shared.ConfigUser{
+				Endpoint:   "https://example.com/broken",
+				EventTypes: config.EventTypes,
+			},
+		}).DoAndReturn(func(_ context.Context, request operations.UpdateConfigRequest, _ ...operations.Option) (*operations.UpdateConfigResponse, error) {
+			config.Endpoint = request.ConfigUser.Endpoint
+			statusCode = http.StatusNotFound
+			return &operations.UpdateConfigResponse{}, nil
+		})
+
+		webhooksSdk.EXPECT().TestConfig(gomock.Any(), operations.TestConfigRequest{
+			ID: config.ID,
+		}).DoAndReturn(func(_ context.Context, _ operations.TestConfigRequest, _ ...operations.Option) (*operations.TestConfigResponse, error) {
+			return &operations.TestConfigResponse{
+				AttemptResponse: &shared.AttemptResponse{
+					Data: shared.Attempt{
+						Config:     config,
+						StatusCode: statusCode,
+						CreatedAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					},
+				},
+			}, nil
+		}).Times(2)
+
+		webhooksSdk.EXPECT().DeleteConfig(gomock.Any(), operations.DeleteConfigRequest{
+			ID: config.ID,
+		}).Return(nil, nil)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/webhooks"
+						event_types = ["ledger.committed_transactions"]
+						verify_on_apply = true
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("verification_status_code"), knownvalue.Int64Exact(http.StatusOK)),
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("verified_at"), knownvalue.StringExact("2025-01-01T00:00:00Z")),
+					},
+				},
+				{
+					Config: providerConfig + `
+					resource "stack_webhooks" "default" {
+						endpoint = "https://example.com/broken"
+						event_types = ["ledger.committed_transactions"]
+						verify_on_apply = true
+						fail_on_verification_error = true
+					}
+				`,
+					ExpectError: regexp.MustCompile(`answered the test event with the HTTP status 404`),
+				},
+			},
+		})
+	})
+}
+
+func TestWebhooksImport(t *testing.T) {
+	t.Parallel()
+	t.Run(t.Name(), func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cloudSdk := sdk.NewMockCloudSDK(ctrl)
+		tokenProvider, _ := testprovider.NewMockTokenProvider(ctrl)
+		stackTokenProvider := pkg.NewMockTokenProviderImpl(ctrl)
+		stacksdk := sdk.NewMockStackSdkImpl(ctrl)
+		webhooksSdk := sdk.NewMockWebhooksSdkImpl(ctrl)
+		stackId := uuid.NewString()
+		organizationId := uuid.NewString()
+
+		stackProvider := server.NewStackProvider(
+			otel.GetTracerProvider(),
+
+			logging.Testing().WithField("test", "webhooks"),
+			server.FormanceStackEndpoint("dummy-endpoint"),
+			server.FormanceStackClientId("organization_dummy-client-id"),
+			server.FormanceStackClientSecret("dummy-client-secret"),
+			transport,
+			newCloudSdkMockT(cloudSdk),
+
+			tokenProvider,
+			func(transport http.RoundTripper, creds cloudpkg.Creds, tokenProvider cloudpkg.TokenProviderImpl, stack pkg.Stack) pkg.TokenProviderImpl {
+				return stackTokenProvider
+			},
+			func(...formance.SDKOption) sdk.StackSdkImpl {
+				return stacksdk
+			},
+		)
+
+		stacksdk.EXPECT().GetVersions(gomock.Any()).Return(&operations.GetVersionsResponse{
+			GetVersionsResponse: &shared.GetVersionsResponse{
+				Versions: []shared.Version{
+					{
+						Name:    "webhooks",
+						Version: "develop",
+						Health:  true,
+					},
+					{
+						Name:    "ledger",
+						Version: "develop",
+						Health:  true,
+					},
+				},
+			},
+		}, nil).AnyTimes()
+		stacksdk.EXPECT().Webhooks().Return(webhooksSdk).AnyTimes()
+
+		// The webhook configuration as stored by the server, which does not return its name
+		config := shared.WebhooksConfig{
+			ID:         uuid.NewString(),
+			Endpoint:   "https://example.com/webhooks",
+			EventTypes: []string{"ledger.committed_transactions"},
+			Secret:     "generated-secret",
+			Active:     true,
+			CreatedAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			UpdatedAt:  time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+		other := shared.WebhooksConfig{
+			ID:         uuid.NewString(),
+			Endpoint:   "https://example.com/other",
+			EventTypes: []string{"payments.saved_payment"},
+			Active:     true,
+		}
+
+		webhooksSdk.EXPECT().InsertConfig(gomock.Any(), shared.ConfigUser{
+			Name:       pointer.For("customer_payment"),
+			Endpoint:   config.Endpoint,
+			EventTypes: config.EventTypes,
+		}).Return(&operations.InsertConfigResponse{
+			ConfigResponse: &shared.ConfigResponse{
+				Data: config,
+			},
+		}, nil)
+
+		webhooksSdk.EXPECT().GetManyConfigs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request operations.GetManyConfigsRequest) (*operations.GetManyConfigsResponse, error) {
+			configs := []shared.WebhooksConfig{}
+			for _, c := range []shared.WebhooksConfig{config, other} {
+				if request.ID != nil && c.ID != *request.ID {
+					continue
+				}
+				if request.Endpoint != nil && c.Endpoint != *request.Endpoint {
+					continue
+				}
+				configs = append(configs, c)
+			}
+			return &operations.GetManyConfigsResponse{
+				ConfigsResponse: &shared.ConfigsResponse{
+					Cursor: shared.ConfigsResponseCursor{
+						Data: configs,
+					},
+				},
+			}, nil
+		}).AnyTimes()
+
+		webhooksSdk.EXPECT().DeleteConfig(gomock.Any(), operations.DeleteConfigRequest{
+			ID: config.ID,
+		}).Return(nil, nil)
+
+		providerConfig := `
+			provider "stack" {
+				stack_id = "` + stackId + `"
+				organization_id = "` + organizationId + `"
+				uri = "` + fmt.Sprintf("https://%s-%s.formance.cloud/api", organizationId, stackId) + `"
+			}
+		`
+		resourceConfig := providerConfig + `
+			resource "stack_webhooks" "default" {
+				name = "customer_payment"
+				endpoint = "https://example.com/webhooks"
+				event_types = ["ledger.committed_transactions"]
+			}
+		`
+
+		resource.ParallelTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stack": providerserver.NewProtocol6WithError(stackProvider()),
+			},
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version0_15_0),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: resourceConfig,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("stack_webhooks.default", tfjsonpath.New("name"), knownvalue.StringExact("customer_payment")),
+					},
+				},
+				{
+					Config:                  resourceConfig,
+					ResourceName:            "stack_webhooks.default",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"name", "effective_secret"},
+				},
+				{
+					Config:                  resourceConfig,
+					ResourceName:            "stack_webhooks.default",
+					ImportState:             true,
+					ImportStateId:           config.Endpoint,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"name", "effective_secret"},
+				},
+				{
+					Config: resourceConfig + `
+					data "stack_webhook_configs" "ledger" {
+						event_type = "ledger.committed_transactions"
+					}
+				`,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("data.stack_webhook_configs.ledger", tfjsonpath.New("configs"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"id":          knownvalue.StringExact(config.ID),
+								"endpoint":    knownvalue.StringExact(config.Endpoint),
+								"event_types": knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("ledger.committed_transactions")}),
+								"active":      knownvalue.Bool(true),
+								"created_at":  knownvalue.StringExact("2025-01-01T00:00:00Z"),
+								"updated_at":  knownvalue.StringExact("2025-01-02T00:00:00Z"),
+							}),
+						})),
+					},
+				},
+			},
+		})
+	})
+}